@@ -0,0 +1,83 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// auditUserKey is the context key under which the current user's ID is
+// stored so Auditable's GORM hooks can stamp CreatedBy/UpdatedBy/DeletedBy.
+type auditUserKey struct{}
+
+// WithCurrentUser returns a context carrying userID as the actor responsible
+// for the writes performed through it. Pass the returned context as the
+// gorm.DB's context (db.WithContext(ctx)) before Create/Save/Delete calls.
+func WithCurrentUser(ctx context.Context, userID uint) context.Context {
+	return context.WithValue(ctx, auditUserKey{}, userID)
+}
+
+func currentUserID(ctx context.Context) uint {
+	userID, _ := ctx.Value(auditUserKey{}).(uint)
+	return userID
+}
+
+// CurrentUserID returns the user ID stored by WithCurrentUser, or zero if ctx
+// carries none. Exported for callers that need to stamp an audit column
+// themselves instead of going through a GORM hook, such as the repository
+// layer's raw Update-based soft delete.
+func CurrentUserID(ctx context.Context) uint {
+	return currentUserID(ctx)
+}
+
+// Auditable centralizes the soft-delete and who/when bookkeeping shared by
+// the CRM models. Embed it anonymously to get CreatedAt/UpdatedAt/DeletedAt
+// plus CreatedBy/UpdatedBy/DeletedBy, the last three populated from the
+// context's current user via the promoted BeforeCreate/BeforeUpdate/
+// BeforeDelete hooks below. DeletedAt is a gorm.DeletedAt so GORM's default
+// query scope filters deleted rows automatically; use WithDeleted to see them.
+type Auditable struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+	CreatedBy uint
+	UpdatedBy uint
+	DeletedBy uint
+}
+
+// BeforeCreate stamps CreatedBy and UpdatedBy from the context's current user.
+func (a *Auditable) BeforeCreate(tx *gorm.DB) error {
+	userID := currentUserID(tx.Statement.Context)
+	a.CreatedBy = userID
+	a.UpdatedBy = userID
+	return nil
+}
+
+// BeforeUpdate stamps UpdatedBy from the context's current user.
+func (a *Auditable) BeforeUpdate(tx *gorm.DB) error {
+	a.UpdatedBy = currentUserID(tx.Statement.Context)
+	return nil
+}
+
+// BeforeDelete stamps DeletedBy from the context's current user before GORM
+// turns the delete into an UPDATE ... SET deleted_at = ?. That UPDATE is
+// built entirely by GORM's soft-delete clause and only ever assigns
+// deleted_at, so a plain field assignment on the receiver never reaches the
+// database; stamp deleted_by with its own UpdateColumn call against the row
+// GORM is about to delete, issued on the same session so it stays part of
+// the same transaction.
+func (a *Auditable) BeforeDelete(tx *gorm.DB) error {
+	userID := currentUserID(tx.Statement.Context)
+	a.DeletedBy = userID
+	if tx.Statement.Unscoped {
+		return nil
+	}
+	return tx.Session(&gorm.Session{}).Model(tx.Statement.Model).UpdateColumn("deleted_by", userID).Error
+}
+
+// WithDeleted returns db with soft-deleted records included, mirroring
+// db.Unscoped() for admin queries that need to see deleted rows.
+func WithDeleted(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
@@ -0,0 +1,102 @@
+package models
+
+import (
+	"context"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open in-memory sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&Lead{}); err != nil {
+		t.Fatalf("failed to migrate Lead: %v", err)
+	}
+	return db
+}
+
+func TestAuditable_SoftDeleteFiltersByDefault(t *testing.T) {
+	db := openTestDB(t)
+
+	lead := Lead{FirstName: "Ada", LastName: "Lovelace"}
+	if err := db.Create(&lead).Error; err != nil {
+		t.Fatalf("failed to create lead: %v", err)
+	}
+
+	if err := db.Delete(&lead).Error; err != nil {
+		t.Fatalf("failed to soft-delete lead: %v", err)
+	}
+
+	var found []Lead
+	if err := db.Find(&found).Error; err != nil {
+		t.Fatalf("failed to query leads: %v", err)
+	}
+	if len(found) != 0 {
+		t.Errorf("expected soft-deleted lead to be filtered out, got %d results", len(found))
+	}
+
+	var withDeleted []Lead
+	if err := WithDeleted(db).Find(&withDeleted).Error; err != nil {
+		t.Fatalf("failed to query leads with WithDeleted: %v", err)
+	}
+	if len(withDeleted) != 1 {
+		t.Fatalf("expected WithDeleted to return the soft-deleted lead, got %d results", len(withDeleted))
+	}
+	if !withDeleted[0].DeletedAt.Valid {
+		t.Errorf("expected DeletedAt to be set on the soft-deleted lead")
+	}
+}
+
+func TestAuditable_StampsCreatedAndUpdatedBy(t *testing.T) {
+	db := openTestDB(t)
+	ctx := WithCurrentUser(context.Background(), 42)
+
+	lead := Lead{FirstName: "Grace", LastName: "Hopper"}
+	if err := db.WithContext(ctx).Create(&lead).Error; err != nil {
+		t.Fatalf("failed to create lead: %v", err)
+	}
+	if lead.CreatedBy != 42 {
+		t.Errorf("CreatedBy: got %d, want %d", lead.CreatedBy, 42)
+	}
+	if lead.UpdatedBy != 42 {
+		t.Errorf("UpdatedBy: got %d, want %d", lead.UpdatedBy, 42)
+	}
+
+	editorCtx := WithCurrentUser(context.Background(), 7)
+	if err := db.WithContext(editorCtx).Model(&lead).Update("status", "Working").Error; err != nil {
+		t.Fatalf("failed to update lead: %v", err)
+	}
+	if lead.UpdatedBy != 7 {
+		t.Errorf("UpdatedBy after update: got %d, want %d", lead.UpdatedBy, 7)
+	}
+	if lead.CreatedBy != 42 {
+		t.Errorf("CreatedBy should be unchanged after update: got %d, want %d", lead.CreatedBy, 42)
+	}
+}
+
+func TestAuditable_StampsDeletedBy(t *testing.T) {
+	db := openTestDB(t)
+
+	lead := Lead{FirstName: "Margaret", LastName: "Hamilton"}
+	if err := db.Create(&lead).Error; err != nil {
+		t.Fatalf("failed to create lead: %v", err)
+	}
+
+	deleterCtx := WithCurrentUser(context.Background(), 99)
+	if err := db.WithContext(deleterCtx).Delete(&lead).Error; err != nil {
+		t.Fatalf("failed to soft-delete lead: %v", err)
+	}
+
+	var deleted Lead
+	if err := WithDeleted(db).First(&deleted, lead.ID).Error; err != nil {
+		t.Fatalf("failed to load soft-deleted lead: %v", err)
+	}
+	if deleted.DeletedBy != 99 {
+		t.Errorf("DeletedBy: got %d, want %d", deleted.DeletedBy, 99)
+	}
+}
@@ -6,6 +6,7 @@ type Contact struct {
 	ID             uint   `gorm:"primaryKey"`
 	UserID         uint   // Foreign key for User
 	LeadID         uint   // Foreign key for Lead
+	AccountID      uint   // Foreign key for Account
 	AssignedTo     uint   // Foreign key for User
 	ReportsTo      uint   // Foreign key for User
 	FirstName      string `gorm:"size:64;not null;default:''"`
@@ -25,8 +26,6 @@ type Contact struct {
 	Twitter        string `gorm:"size:128"`
 	BornOn         *time.Time
 	DoNotCall      bool `gorm:"not null;default:false"`
-	DeletedAt      *time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Auditable
 	BackgroundInfo string `gorm:"size:255"`
 }
@@ -1,7 +1,5 @@
 package models
 
-import "time"
-
 type Lead struct {
 	ID             uint   `gorm:"primaryKey"`
 	UserID         uint   // Foreign key for User
@@ -25,8 +23,6 @@ type Lead struct {
 	Twitter        string `gorm:"size:128"`
 	Rating         int    `gorm:"not null;default:0"`
 	DoNotCall      bool   `gorm:"not null;default:false"`
-	DeletedAt      *time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Auditable
 	BackgroundInfo string `gorm:"size:255"`
 }
@@ -0,0 +1,157 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Sentinel errors returned by (*Lead).Promote instead of an opaque wrapped
+// error, so callers can match them with errors.Is.
+var (
+	// ErrLeadMissingEmail is returned when promoting a Lead with no Email,
+	// since the resulting Contact requires one.
+	ErrLeadMissingEmail = errors.New("models: lead email is required to promote")
+	// ErrLeadAlreadyConverted is returned when promoting a Lead whose Status
+	// is already "converted".
+	ErrLeadAlreadyConverted = errors.New("models: lead is already converted")
+)
+
+// leadConvertedStatus is the Status value a Lead is stamped with once it has
+// been promoted, mirroring the Rails source's "converted" lead state.
+const leadConvertedStatus = "converted"
+
+// PromoteOptions configures the Opportunity optionally created by
+// (*Lead).Promote. CreateOpportunity is false by default, matching the
+// Rails source where opportunity creation is a separate, operator-driven
+// step from lead conversion.
+type PromoteOptions struct {
+	CreateOpportunity bool
+	OpportunityName   string
+	OpportunityStage  string
+	OpportunityAmount *Money
+	ClosesOn          *time.Time
+}
+
+// PromotionResult describes the records produced by a successful
+// (*Lead).Promote call. It is handed to OnLeadPromoted so downstream
+// systems (notifications, search indexing, ...) can react without Promote
+// needing to know about them.
+type PromotionResult struct {
+	Lead        *Lead
+	Contact     *Contact
+	Account     *Account
+	Opportunity *Opportunity
+}
+
+// OnLeadPromoted, if non-nil, is invoked with the result of every successful
+// (*Lead).Promote call after its transaction has committed. It is nil by
+// default; downstream systems assign their own handler during
+// initialization. Promote does not serialize calls to it, so a handler that
+// is not safe for concurrent use must do its own locking.
+var OnLeadPromoted func(PromotionResult)
+
+// Promote converts l into a Contact, reusing or creating an Account from
+// l.Company, and optionally opening an Opportunity, the way the Rails
+// source's Lead#promote did. All inserts happen in a single transaction on
+// db: if any step fails, including opportunity creation, nothing is
+// persisted and l.Status is left untouched.
+//
+// On success l.Status is set to "converted" and OnLeadPromoted, if set, is
+// called with the resulting records.
+func (l *Lead) Promote(db *gorm.DB, opts PromoteOptions) (*Contact, *Account, *Opportunity, error) {
+	if l.Status == leadConvertedStatus {
+		return nil, nil, nil, ErrLeadAlreadyConverted
+	}
+	if l.Email == "" {
+		return nil, nil, nil, ErrLeadMissingEmail
+	}
+
+	var (
+		contact     Contact
+		account     *Account
+		opportunity *Opportunity
+	)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if l.Company != "" {
+			account = &Account{}
+			if err := tx.Where("name = ?", l.Company).First(account).Error; err != nil {
+				if !errors.Is(err, gorm.ErrRecordNotFound) {
+					return err
+				}
+				account = &Account{
+					UserID:     l.UserID,
+					AssignedTo: l.AssignedTo,
+					Name:       l.Company,
+				}
+				if err := tx.Create(account).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		contact = Contact{
+			UserID:     l.UserID,
+			LeadID:     l.ID,
+			AssignedTo: l.AssignedTo,
+			Access:     l.Access,
+			FirstName:  l.FirstName,
+			LastName:   l.LastName,
+			Title:      l.Title,
+			Source:     l.Source,
+			Email:      l.Email,
+			AltEmail:   l.AltEmail,
+			Phone:      l.Phone,
+			Mobile:     l.Mobile,
+			Blog:       l.Blog,
+			Linkedin:   l.Linkedin,
+			Facebook:   l.Facebook,
+			Twitter:    l.Twitter,
+			DoNotCall:  l.DoNotCall,
+		}
+		if account != nil {
+			contact.AccountID = account.ID
+		}
+		if err := tx.Create(&contact).Error; err != nil {
+			return err
+		}
+
+		if opts.CreateOpportunity {
+			opportunity = &Opportunity{
+				UserID:     l.UserID,
+				CampaignID: l.CampaignID,
+				AssignedTo: l.AssignedTo,
+				Access:     l.Access,
+				Name:       opts.OpportunityName,
+				Stage:      opts.OpportunityStage,
+				Amount:     opts.OpportunityAmount,
+				ClosesOn:   opts.ClosesOn,
+			}
+			if account != nil {
+				opportunity.AccountID = account.ID
+			}
+			if err := tx.Create(opportunity).Error; err != nil {
+				return err
+			}
+		}
+
+		l.Status = leadConvertedStatus
+		return tx.Save(l).Error
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if OnLeadPromoted != nil {
+		OnLeadPromoted(PromotionResult{
+			Lead:        l,
+			Contact:     &contact,
+			Account:     account,
+			Opportunity: opportunity,
+		})
+	}
+
+	return &contact, account, opportunity, nil
+}
@@ -0,0 +1,191 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// openPromoteTestDB opens an isolated in-memory sqlite database migrated
+// with every model Promote touches. It is named after the running (sub)test
+// so parallel subtests don't share rows through sqlite's shared cache.
+func openPromoteTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open in-memory sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&Lead{}, &Contact{}, &Account{}, &Opportunity{}); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	return db
+}
+
+func TestLeadPromote(t *testing.T) {
+	t.Run("creates a Contact and Account and converts the Lead", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		lead := Lead{FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", Company: "Analytical Engines Inc", AssignedTo: 7, Access: "Private"}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		contact, account, opportunity, err := lead.Promote(db, PromoteOptions{})
+		if err != nil {
+			t.Fatalf("Promote returned error: %v", err)
+		}
+		if opportunity != nil {
+			t.Errorf("expected no Opportunity to be created, got %+v", opportunity)
+		}
+		if contact.Email != lead.Email || contact.AssignedTo != lead.AssignedTo || contact.Access != lead.Access {
+			t.Errorf("Contact did not mirror Lead fields: %+v", contact)
+		}
+		if account.Name != lead.Company {
+			t.Errorf("Account.Name: got %q, want %q", account.Name, lead.Company)
+		}
+		if contact.AccountID != account.ID {
+			t.Errorf("Contact.AccountID: got %d, want %d", contact.AccountID, account.ID)
+		}
+		if lead.Status != leadConvertedStatus {
+			t.Errorf("Lead.Status: got %q, want %q", lead.Status, leadConvertedStatus)
+		}
+	})
+
+	t.Run("reuses an existing Account for a duplicate company", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		existing := Account{Name: "Shared Co"}
+		if err := db.Create(&existing).Error; err != nil {
+			t.Fatalf("failed to seed account: %v", err)
+		}
+
+		lead := Lead{FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com", Company: "Shared Co"}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		_, account, _, err := lead.Promote(db, PromoteOptions{})
+		if err != nil {
+			t.Fatalf("Promote returned error: %v", err)
+		}
+		if account.ID != existing.ID {
+			t.Errorf("expected Promote to reuse account %d, got a new account %d", existing.ID, account.ID)
+		}
+
+		var count int64
+		if err := db.Model(&Account{}).Where("name = ?", "Shared Co").Count(&count).Error; err != nil {
+			t.Fatalf("failed to count accounts: %v", err)
+		}
+		if count != 1 {
+			t.Errorf("expected exactly one Account named %q, got %d", "Shared Co", count)
+		}
+	})
+
+	t.Run("rejects a Lead with no email", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		lead := Lead{FirstName: "Margaret", LastName: "Hamilton"}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		if _, _, _, err := lead.Promote(db, PromoteOptions{}); !errors.Is(err, ErrLeadMissingEmail) {
+			t.Fatalf("expected ErrLeadMissingEmail, got %v", err)
+		}
+
+		var contacts []Contact
+		if err := db.Find(&contacts).Error; err != nil {
+			t.Fatalf("failed to query contacts: %v", err)
+		}
+		if len(contacts) != 0 {
+			t.Errorf("expected no Contact to be created, got %d", len(contacts))
+		}
+	})
+
+	t.Run("rolls back all inserts when opportunity creation fails", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		lead := Lead{FirstName: "Katherine", LastName: "Johnson", Email: "katherine@example.com", Company: "New Horizons"}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		// Dropping the opportunities table forces Create to fail inside the
+		// transaction, exercising the rollback path.
+		if err := db.Migrator().DropTable(&Opportunity{}); err != nil {
+			t.Fatalf("failed to drop opportunities table: %v", err)
+		}
+
+		amount := NewMoneyFromFloat(1000)
+		_, _, _, err := lead.Promote(db, PromoteOptions{
+			CreateOpportunity: true,
+			OpportunityName:   "New Horizons Deal",
+			OpportunityAmount: &amount,
+		})
+		if err == nil {
+			t.Fatal("expected Promote to fail when opportunity creation fails")
+		}
+
+		var accounts []Account
+		if err := db.Find(&accounts).Error; err != nil {
+			t.Fatalf("failed to query accounts: %v", err)
+		}
+		if len(accounts) != 0 {
+			t.Errorf("expected Account insert to be rolled back, got %d accounts", len(accounts))
+		}
+
+		var contacts []Contact
+		if err := db.Find(&contacts).Error; err != nil {
+			t.Fatalf("failed to query contacts: %v", err)
+		}
+		if len(contacts) != 0 {
+			t.Errorf("expected Contact insert to be rolled back, got %d contacts", len(contacts))
+		}
+
+		var reloaded Lead
+		if err := db.First(&reloaded, lead.ID).Error; err != nil {
+			t.Fatalf("failed to reload lead: %v", err)
+		}
+		if reloaded.Status == leadConvertedStatus {
+			t.Errorf("expected Lead.Status to be rolled back, got %q", reloaded.Status)
+		}
+	})
+
+	t.Run("invokes OnLeadPromoted with the resulting records", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		lead := Lead{FirstName: "Hedy", LastName: "Lamarr", Email: "hedy@example.com"}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		var captured *PromotionResult
+		prev := OnLeadPromoted
+		OnLeadPromoted = func(r PromotionResult) { captured = &r }
+		defer func() { OnLeadPromoted = prev }()
+
+		if _, _, _, err := lead.Promote(db, PromoteOptions{}); err != nil {
+			t.Fatalf("Promote returned error: %v", err)
+		}
+		if captured == nil {
+			t.Fatal("expected OnLeadPromoted to be called")
+		}
+		if captured.Contact == nil || captured.Contact.Email != lead.Email {
+			t.Errorf("PromotionResult.Contact: got %+v", captured.Contact)
+		}
+		if captured.Account != nil {
+			t.Errorf("expected PromotionResult.Account to be nil for a Lead with no Company, got %+v", captured.Account)
+		}
+	})
+
+	t.Run("rejects an already converted Lead", func(t *testing.T) {
+		db := openPromoteTestDB(t)
+		lead := Lead{FirstName: "Rear", LastName: "Admiral", Email: "r@example.com", Status: leadConvertedStatus}
+		if err := db.Create(&lead).Error; err != nil {
+			t.Fatalf("failed to create lead: %v", err)
+		}
+
+		if _, _, _, err := lead.Promote(db, PromoteOptions{}); !errors.Is(err, ErrLeadAlreadyConverted) {
+			t.Fatalf("expected ErrLeadAlreadyConverted, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Money represents a monetary amount as an exact base-10 decimal rather than
+// the binary floating-point math/big.Float it replaces. Embedding
+// decimal.Decimal gives Money sql.Scanner, driver.Valuer, and
+// json.Marshaler/Unmarshaler for free, so a decimal(12,2) column and a JSON
+// "12345.67" both round-trip byte-identically instead of losing pennies to
+// base-2 rounding.
+type Money struct {
+	decimal.Decimal
+}
+
+// NewMoney wraps d as a Money.
+func NewMoney(d decimal.Decimal) Money {
+	return Money{d}
+}
+
+// NewMoneyFromFloat builds a Money from a float64, matching the convenience
+// constructors callers previously reached for on *big.Float.
+func NewMoneyFromFloat(f float64) Money {
+	return Money{decimal.NewFromFloat(f)}
+}
+
+// ParseMoney parses s (e.g. "12345.67") as a Money, returning an error for
+// malformed input instead of the silent zero value big.Float's SetString
+// would leave callers to check for separately.
+func ParseMoney(s string) (Money, error) {
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{d}, nil
+}
+
+// Add returns m + other.
+func (m Money) Add(other Money) Money {
+	return Money{m.Decimal.Add(other.Decimal)}
+}
+
+// Sub returns m - other.
+func (m Money) Sub(other Money) Money {
+	return Money{m.Decimal.Sub(other.Decimal)}
+}
+
+// Mul returns m * other.
+func (m Money) Mul(other Money) Money {
+	return Money{m.Decimal.Mul(other.Decimal)}
+}
+
+// ApplyDiscountPercent returns m reduced by percent percent (e.g. 15 for
+// 15%), rounded to 2 decimal places.
+func (m Money) ApplyDiscountPercent(percent float64) Money {
+	factor := decimal.NewFromFloat(1 - percent/100)
+	return Money{m.Decimal.Mul(factor).Round(2)}
+}
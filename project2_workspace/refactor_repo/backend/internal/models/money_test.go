@@ -0,0 +1,77 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestMoney_GormRoundTrip(t *testing.T) {
+	db := openTestDB(t)
+	if err := db.AutoMigrate(&Opportunity{}); err != nil {
+		t.Fatalf("failed to migrate Opportunity: %v", err)
+	}
+
+	amount, err := ParseMoney("12345.67")
+	if err != nil {
+		t.Fatalf("ParseMoney: %v", err)
+	}
+
+	opp := Opportunity{Name: "Round Trip", Amount: &amount}
+	if err := db.Create(&opp).Error; err != nil {
+		t.Fatalf("failed to create opportunity: %v", err)
+	}
+
+	var reloaded Opportunity
+	if err := db.First(&reloaded, opp.ID).Error; err != nil {
+		t.Fatalf("failed to reload opportunity: %v", err)
+	}
+	if reloaded.Amount == nil {
+		t.Fatal("expected Amount to survive the round trip")
+	}
+	if reloaded.Amount.String() != "12345.67" {
+		t.Errorf("Amount: got %s, want 12345.67", reloaded.Amount.String())
+	}
+	if !reloaded.Amount.Equal(amount.Decimal) {
+		t.Errorf("Amount: got %s, want %s (exact decimal mismatch)", reloaded.Amount.String(), amount.String())
+	}
+}
+
+func TestMoney_JSONRoundTrip(t *testing.T) {
+	m, err := ParseMoney("12345.67")
+	if err != nil {
+		t.Fatalf("ParseMoney: %v", err)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var decoded Money
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !decoded.Equal(m.Decimal) {
+		t.Errorf("decoded Money: got %s, want %s", decoded.String(), m.String())
+	}
+}
+
+func TestMoney_Arithmetic(t *testing.T) {
+	a := NewMoneyFromFloat(100.50)
+	b := NewMoneyFromFloat(25.25)
+
+	if got := a.Add(b); got.String() != "125.75" {
+		t.Errorf("Add: got %s, want 125.75", got.String())
+	}
+	if got := a.Sub(b); got.String() != "75.25" {
+		t.Errorf("Sub: got %s, want 75.25", got.String())
+	}
+	if got := NewMoneyFromFloat(10).Mul(NewMoneyFromFloat(3)); got.String() != "30" {
+		t.Errorf("Mul: got %s, want 30", got.String())
+	}
+	if got := NewMoneyFromFloat(200).ApplyDiscountPercent(15); got.String() != decimal.NewFromFloat(170).String() {
+		t.Errorf("ApplyDiscountPercent: got %s, want 170", got.String())
+	}
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// AccountRepository provides validated, soft-delete-aware access to Account
+// rows.
+type AccountRepository struct {
+	crud
+}
+
+// NewAccountRepository returns an AccountRepository backed by db.
+func NewAccountRepository(db *gorm.DB) *AccountRepository {
+	return &AccountRepository{crud: crud{db: db}}
+}
+
+// Create validates account's sized fields and inserts it.
+func (r *AccountRepository) Create(ctx context.Context, account *models.Account) error {
+	return r.create(ctx, account)
+}
+
+// Get loads the non-deleted Account with the given id.
+func (r *AccountRepository) Get(ctx context.Context, id uint) (*models.Account, error) {
+	var account models.Account
+	if err := r.get(ctx, &account, id); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// Update validates account's sized fields and saves it.
+func (r *AccountRepository) Update(ctx context.Context, account *models.Account) error {
+	return r.update(ctx, account)
+}
+
+// Delete soft-deletes the Account with the given id.
+func (r *AccountRepository) Delete(ctx context.Context, id uint) error {
+	return r.delete(ctx, &models.Account{}, id)
+}
+
+// List returns non-deleted Accounts matching f.
+func (r *AccountRepository) List(ctx context.Context, f Filter) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.list(ctx, &accounts, f); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// WithTrashed returns Accounts matching f, including soft-deleted ones.
+func (r *AccountRepository) WithTrashed(ctx context.Context, f Filter) ([]models.Account, error) {
+	var accounts []models.Account
+	if err := r.listWithTrashed(ctx, &accounts, f); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// Restore clears DeletedAt on the soft-deleted Account with the given id.
+func (r *AccountRepository) Restore(ctx context.Context, id uint) error {
+	return r.restore(ctx, &models.Account{}, id)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// CampaignRepository provides validated, soft-delete-aware access to
+// Campaign rows.
+type CampaignRepository struct {
+	crud
+}
+
+// NewCampaignRepository returns a CampaignRepository backed by db.
+func NewCampaignRepository(db *gorm.DB) *CampaignRepository {
+	return &CampaignRepository{crud: crud{db: db}}
+}
+
+// Create validates campaign's sized fields and inserts it.
+func (r *CampaignRepository) Create(ctx context.Context, campaign *models.Campaign) error {
+	return r.create(ctx, campaign)
+}
+
+// Get loads the non-deleted Campaign with the given id.
+func (r *CampaignRepository) Get(ctx context.Context, id uint) (*models.Campaign, error) {
+	var campaign models.Campaign
+	if err := r.get(ctx, &campaign, id); err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+// Update validates campaign's sized fields and saves it.
+func (r *CampaignRepository) Update(ctx context.Context, campaign *models.Campaign) error {
+	return r.update(ctx, campaign)
+}
+
+// Delete soft-deletes the Campaign with the given id.
+func (r *CampaignRepository) Delete(ctx context.Context, id uint) error {
+	return r.delete(ctx, &models.Campaign{}, id)
+}
+
+// List returns non-deleted Campaigns matching f.
+func (r *CampaignRepository) List(ctx context.Context, f Filter) ([]models.Campaign, error) {
+	var campaigns []models.Campaign
+	if err := r.list(ctx, &campaigns, f); err != nil {
+		return nil, err
+	}
+	return campaigns, nil
+}
+
+// WithTrashed returns Campaigns matching f, including soft-deleted ones.
+func (r *CampaignRepository) WithTrashed(ctx context.Context, f Filter) ([]models.Campaign, error) {
+	var campaigns []models.Campaign
+	if err := r.listWithTrashed(ctx, &campaigns, f); err != nil {
+		return nil, err
+	}
+	return campaigns, nil
+}
+
+// Restore clears DeletedAt on the soft-deleted Campaign with the given id.
+func (r *CampaignRepository) Restore(ctx context.Context, id uint) error {
+	return r.restore(ctx, &models.Campaign{}, id)
+}
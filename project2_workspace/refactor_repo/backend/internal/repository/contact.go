@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// ContactRepository provides validated, soft-delete-aware access to Contact
+// rows.
+type ContactRepository struct {
+	crud
+}
+
+// NewContactRepository returns a ContactRepository backed by db.
+func NewContactRepository(db *gorm.DB) *ContactRepository {
+	return &ContactRepository{crud: crud{db: db}}
+}
+
+// Create validates contact's sized fields and inserts it.
+func (r *ContactRepository) Create(ctx context.Context, contact *models.Contact) error {
+	return r.create(ctx, contact)
+}
+
+// Get loads the non-deleted Contact with the given id.
+func (r *ContactRepository) Get(ctx context.Context, id uint) (*models.Contact, error) {
+	var contact models.Contact
+	if err := r.get(ctx, &contact, id); err != nil {
+		return nil, err
+	}
+	return &contact, nil
+}
+
+// Update validates contact's sized fields and saves it.
+func (r *ContactRepository) Update(ctx context.Context, contact *models.Contact) error {
+	return r.update(ctx, contact)
+}
+
+// Delete soft-deletes the Contact with the given id.
+func (r *ContactRepository) Delete(ctx context.Context, id uint) error {
+	return r.delete(ctx, &models.Contact{}, id)
+}
+
+// List returns non-deleted Contacts matching f.
+func (r *ContactRepository) List(ctx context.Context, f Filter) ([]models.Contact, error) {
+	var contacts []models.Contact
+	if err := r.list(ctx, &contacts, f); err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+// WithTrashed returns Contacts matching f, including soft-deleted ones.
+func (r *ContactRepository) WithTrashed(ctx context.Context, f Filter) ([]models.Contact, error) {
+	var contacts []models.Contact
+	if err := r.listWithTrashed(ctx, &contacts, f); err != nil {
+		return nil, err
+	}
+	return contacts, nil
+}
+
+// Restore clears DeletedAt on the soft-deleted Contact with the given id.
+func (r *ContactRepository) Restore(ctx context.Context, id uint) error {
+	return r.restore(ctx, &models.Contact{}, id)
+}
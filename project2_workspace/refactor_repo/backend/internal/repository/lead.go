@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// LeadRepository provides validated, soft-delete-aware access to Lead rows.
+type LeadRepository struct {
+	crud
+}
+
+// NewLeadRepository returns a LeadRepository backed by db.
+func NewLeadRepository(db *gorm.DB) *LeadRepository {
+	return &LeadRepository{crud: crud{db: db}}
+}
+
+// Create validates lead's sized fields and inserts it.
+func (r *LeadRepository) Create(ctx context.Context, lead *models.Lead) error {
+	return r.create(ctx, lead)
+}
+
+// Get loads the non-deleted Lead with the given id.
+func (r *LeadRepository) Get(ctx context.Context, id uint) (*models.Lead, error) {
+	var lead models.Lead
+	if err := r.get(ctx, &lead, id); err != nil {
+		return nil, err
+	}
+	return &lead, nil
+}
+
+// Update validates lead's sized fields and saves it.
+func (r *LeadRepository) Update(ctx context.Context, lead *models.Lead) error {
+	return r.update(ctx, lead)
+}
+
+// Delete soft-deletes the Lead with the given id.
+func (r *LeadRepository) Delete(ctx context.Context, id uint) error {
+	return r.delete(ctx, &models.Lead{}, id)
+}
+
+// List returns non-deleted Leads matching f.
+func (r *LeadRepository) List(ctx context.Context, f Filter) ([]models.Lead, error) {
+	var leads []models.Lead
+	if err := r.list(ctx, &leads, f); err != nil {
+		return nil, err
+	}
+	return leads, nil
+}
+
+// WithTrashed returns Leads matching f, including soft-deleted ones.
+func (r *LeadRepository) WithTrashed(ctx context.Context, f Filter) ([]models.Lead, error) {
+	var leads []models.Lead
+	if err := r.listWithTrashed(ctx, &leads, f); err != nil {
+		return nil, err
+	}
+	return leads, nil
+}
+
+// Restore clears DeletedAt on the soft-deleted Lead with the given id.
+func (r *LeadRepository) Restore(ctx context.Context, id uint) error {
+	return r.restore(ctx, &models.Lead{}, id)
+}
@@ -0,0 +1,217 @@
+// Package repository provides per-model data access for the CRM models:
+// validated Create/Update, soft-delete-aware Get/Delete/List, and the
+// WithTrashed/Restore pair admin flows need to see and undo a soft delete.
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// ErrValidation is returned by Create/Update when a string field exceeds the
+// size declared in its model's `gorm:"size:N"` tag, before any SQL runs.
+var ErrValidation = errors.New("repository: validation failed")
+
+// defaultPageSize is the List page size applied when a Filter's Limit is
+// zero or negative.
+const defaultPageSize = 50
+
+// Filter is the query DSL List/WithTrashed accept. Every field is optional;
+// unset (nil or zero) fields are left out of the WHERE clause entirely. Not
+// every field applies to every model - Status and Rating, for instance, only
+// exist on some of the CRM tables - so callers should only set the fields
+// that correspond to real columns on the model they're filtering.
+type Filter struct {
+	// Owner restricts results to rows whose user_id (the owning user) matches.
+	Owner *uint
+	// Assignee restricts results to rows whose assigned_to matches.
+	Assignee *uint
+	// Status restricts results to rows with this exact status.
+	Status *string
+	// RatingMin and RatingMax bound rating (inclusive) when either is set.
+	RatingMin *int
+	RatingMax *int
+	// CreatedAfter and CreatedBefore bound created_at: >= CreatedAfter and
+	// < CreatedBefore when set.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	// Cursor, when non-zero, restricts results to rows with id > Cursor, for
+	// keyset pagination: pass the last ID seen on the previous page.
+	Cursor uint
+	// Limit caps the number of rows returned. Defaults to defaultPageSize.
+	Limit int
+}
+
+// apply adds f's conditions and id-ordered, cursor-paginated Limit to db.
+// It does not touch the soft-delete scope; callers add that separately so
+// List and WithTrashed can share this logic.
+func (f Filter) apply(db *gorm.DB) *gorm.DB {
+	if f.Owner != nil {
+		db = db.Where("user_id = ?", *f.Owner)
+	}
+	if f.Assignee != nil {
+		db = db.Where("assigned_to = ?", *f.Assignee)
+	}
+	if f.Status != nil {
+		db = db.Where("status = ?", *f.Status)
+	}
+	if f.RatingMin != nil {
+		db = db.Where("rating >= ?", *f.RatingMin)
+	}
+	if f.RatingMax != nil {
+		db = db.Where("rating <= ?", *f.RatingMax)
+	}
+	if f.CreatedAfter != nil {
+		db = db.Where("created_at >= ?", *f.CreatedAfter)
+	}
+	if f.CreatedBefore != nil {
+		db = db.Where("created_at < ?", *f.CreatedBefore)
+	}
+	if f.Cursor > 0 {
+		db = db.Where("id > ?", f.Cursor)
+	}
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	return db.Order("id ASC").Limit(limit)
+}
+
+// crud holds the Create/Get/Update/Delete/List/Restore plumbing shared by
+// every per-model repository in this package. Each repository embeds it and
+// adds nothing but typed method signatures, so soft-delete, validation, and
+// pagination behave identically across models regardless of whether the
+// underlying model's DeletedAt is a gorm.DeletedAt (Auditable) or a plain
+// *time.Time (Account, User): crud never relies on GORM's automatic
+// soft-delete scope, it always filters/sets the deleted_at column itself.
+type crud struct {
+	db *gorm.DB
+}
+
+func (c crud) create(ctx context.Context, v interface{}) error {
+	if err := validateSizes(v); err != nil {
+		return err
+	}
+	return c.db.WithContext(ctx).Create(v).Error
+}
+
+func (c crud) get(ctx context.Context, dest interface{}, id uint) error {
+	return c.db.WithContext(ctx).Where("deleted_at IS NULL").First(dest, id).Error
+}
+
+func (c crud) update(ctx context.Context, v interface{}) error {
+	if err := validateSizes(v); err != nil {
+		return err
+	}
+	return c.db.WithContext(ctx).Save(v).Error
+}
+
+func (c crud) delete(ctx context.Context, model interface{}, id uint) error {
+	updates := map[string]interface{}{"deleted_at": time.Now()}
+	if hasField(model, "DeletedBy") {
+		updates["deleted_by"] = models.CurrentUserID(ctx)
+	}
+	return c.db.WithContext(ctx).Model(model).Where("id = ?", id).Updates(updates).Error
+}
+
+func (c crud) list(ctx context.Context, dest interface{}, f Filter) error {
+	return f.apply(c.db.WithContext(ctx).Where("deleted_at IS NULL")).Find(dest).Error
+}
+
+func (c crud) listWithTrashed(ctx context.Context, dest interface{}, f Filter) error {
+	return f.apply(c.db.WithContext(ctx).Unscoped()).Find(dest).Error
+}
+
+func (c crud) restore(ctx context.Context, model interface{}, id uint) error {
+	return c.db.WithContext(ctx).Unscoped().Model(model).Where("id = ?", id).Update("deleted_at", nil).Error
+}
+
+// hasField reports whether v's underlying struct has an exported field named
+// fieldName, recursing into anonymous embeds (e.g. models.Auditable) the same
+// way Go's own field promotion does. It's used by crud.delete to tell
+// Auditable models (which have DeletedBy) apart from the plain-*time.Time
+// models (Account, User) that don't, since crud.delete issues its own Update
+// rather than going through GORM's BeforeDelete hook.
+func hasField(v interface{}, fieldName string) bool {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	return hasStructField(rv.Type(), fieldName)
+}
+
+func hasStructField(rt reflect.Type, fieldName string) bool {
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Name == fieldName {
+			return true
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Struct && hasStructField(field.Type, fieldName) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSizes walks v's exported string fields (recursing into anonymous
+// embedded structs such as models.Auditable) and returns ErrValidation if
+// any exceeds the limit declared in its `gorm:"size:N"` tag.
+func validateSizes(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	return validateStructSizes(rv)
+}
+
+func validateStructSizes(rv reflect.Value) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		fv := rv.Field(i)
+
+		if field.Anonymous && fv.Kind() == reflect.Struct {
+			if err := validateStructSizes(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if fv.Kind() != reflect.String {
+			continue
+		}
+		size, ok := sizeFromTag(field.Tag.Get("gorm"))
+		if !ok {
+			continue
+		}
+		if fv.Len() > size {
+			return fmt.Errorf("%w: %s.%s exceeds size %d (got %d)", ErrValidation, rt.Name(), field.Name, size, fv.Len())
+		}
+	}
+	return nil
+}
+
+// sizeFromTag extracts the N in a `size:N` clause from a GORM tag string.
+func sizeFromTag(tag string) (int, bool) {
+	for _, part := range strings.Split(tag, ";") {
+		rest, ok := strings.CutPrefix(part, "size:")
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(rest)
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	}
+	return 0, false
+}
@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+func openTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", t.Name())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open in-memory sqlite: %v", err)
+	}
+	if err := db.AutoMigrate(&models.Lead{}, &models.Campaign{}, &models.Contact{}, &models.Account{}, &models.User{}); err != nil {
+		t.Fatalf("failed to migrate models: %v", err)
+	}
+	return db
+}
+
+func TestLeadRepository_CreateValidatesSize(t *testing.T) {
+	repo := NewLeadRepository(openTestDB(t))
+	ctx := context.Background()
+
+	lead := &models.Lead{FirstName: "Ada", LastName: "Lovelace", Source: "this source string is way too long to fit in a size-32 column, which is exactly what we're testing here"}
+	if err := repo.Create(ctx, lead); err == nil {
+		t.Fatal("expected an error creating a Lead with an oversized field, got nil")
+	}
+}
+
+func TestLeadRepository_CreateGetUpdateDelete(t *testing.T) {
+	repo := NewLeadRepository(openTestDB(t))
+	ctx := context.Background()
+
+	lead := &models.Lead{FirstName: "Grace", LastName: "Hopper", Status: "New", Rating: 3}
+	if err := repo.Create(ctx, lead); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	got, err := repo.Get(ctx, lead.ID)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got.FirstName != "Grace" {
+		t.Errorf("FirstName: got %q, want %q", got.FirstName, "Grace")
+	}
+
+	got.Status = "Working"
+	if err := repo.Update(ctx, got); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	updated, err := repo.Get(ctx, lead.ID)
+	if err != nil {
+		t.Fatalf("Get after update failed: %v", err)
+	}
+	if updated.Status != "Working" {
+		t.Errorf("Status after update: got %q, want %q", updated.Status, "Working")
+	}
+
+	if err := repo.Delete(ctx, lead.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, lead.ID); err == nil {
+		t.Fatal("expected Get to fail for a soft-deleted Lead")
+	}
+}
+
+func TestLeadRepository_SoftDeleteRestoreLifecycle(t *testing.T) {
+	repo := NewLeadRepository(openTestDB(t))
+	ctx := context.Background()
+
+	lead := &models.Lead{FirstName: "Margaret", LastName: "Hamilton"}
+	if err := repo.Create(ctx, lead); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, lead.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	if found, err := repo.List(ctx, Filter{}); err != nil {
+		t.Fatalf("List failed: %v", err)
+	} else if len(found) != 0 {
+		t.Errorf("expected List to exclude the soft-deleted Lead, got %d results", len(found))
+	}
+
+	trashed, err := repo.WithTrashed(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("WithTrashed failed: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected WithTrashed to return the soft-deleted Lead, got %d results", len(trashed))
+	}
+
+	if err := repo.Restore(ctx, lead.ID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, lead.ID); err != nil {
+		t.Fatalf("expected Get to succeed after Restore: %v", err)
+	}
+}
+
+func TestLeadRepository_ListFilters(t *testing.T) {
+	repo := NewLeadRepository(openTestDB(t))
+	ctx := context.Background()
+
+	leads := []*models.Lead{
+		{FirstName: "A", LastName: "One", AssignedTo: 1, Status: "New", Rating: 1},
+		{FirstName: "B", LastName: "Two", AssignedTo: 2, Status: "Working", Rating: 5},
+		{FirstName: "C", LastName: "Three", AssignedTo: 1, Status: "Working", Rating: 9},
+	}
+	for _, lead := range leads {
+		if err := repo.Create(ctx, lead); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	assignee := uint(1)
+	found, err := repo.List(ctx, Filter{Assignee: &assignee})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Errorf("Assignee filter: got %d results, want 2", len(found))
+	}
+
+	status := "Working"
+	ratingMin := 3
+	found, err = repo.List(ctx, Filter{Status: &status, RatingMin: &ratingMin})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Errorf("Status+RatingMin filter: got %d results, want 2", len(found))
+	}
+
+	found, err = repo.List(ctx, Filter{Cursor: leads[0].ID})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(found) != 2 {
+		t.Errorf("Cursor filter: got %d results, want 2", len(found))
+	}
+}
+
+func TestCampaignRepository_SoftDeleteRestoreLifecycle(t *testing.T) {
+	repo := NewCampaignRepository(openTestDB(t))
+	ctx := context.Background()
+
+	campaign := &models.Campaign{Name: "Spring Launch", Status: "Active"}
+	if err := repo.Create(ctx, campaign); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, campaign.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, campaign.ID); err == nil {
+		t.Fatal("expected Get to fail for a soft-deleted Campaign")
+	}
+
+	trashed, err := repo.WithTrashed(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("WithTrashed failed: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected WithTrashed to return the soft-deleted Campaign, got %d results", len(trashed))
+	}
+
+	if err := repo.Restore(ctx, campaign.ID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	restored, err := repo.Get(ctx, campaign.ID)
+	if err != nil {
+		t.Fatalf("expected Get to succeed after Restore: %v", err)
+	}
+	if restored.Name != "Spring Launch" {
+		t.Errorf("Name after restore: got %q, want %q", restored.Name, "Spring Launch")
+	}
+}
+
+func TestContactRepository_SoftDeleteRestoreLifecycle(t *testing.T) {
+	repo := NewContactRepository(openTestDB(t))
+	ctx := context.Background()
+
+	contact := &models.Contact{FirstName: "Katherine", LastName: "Johnson"}
+	if err := repo.Create(ctx, contact); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, contact.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, contact.ID); err == nil {
+		t.Fatal("expected Get to fail for a soft-deleted Contact")
+	}
+
+	trashed, err := repo.WithTrashed(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("WithTrashed failed: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected WithTrashed to return the soft-deleted Contact, got %d results", len(trashed))
+	}
+
+	if err := repo.Restore(ctx, contact.ID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	restored, err := repo.Get(ctx, contact.ID)
+	if err != nil {
+		t.Fatalf("expected Get to succeed after Restore: %v", err)
+	}
+	if restored.LastName != "Johnson" {
+		t.Errorf("LastName after restore: got %q, want %q", restored.LastName, "Johnson")
+	}
+}
+
+func TestAccountRepository_SoftDeleteRestoreLifecycle(t *testing.T) {
+	repo := NewAccountRepository(openTestDB(t))
+	ctx := context.Background()
+
+	account := &models.Account{Name: "Acme Corp", Rating: 4}
+	if err := repo.Create(ctx, account); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, account.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.Get(ctx, account.ID); err == nil {
+		t.Fatal("expected Get to fail for a soft-deleted Account")
+	}
+
+	trashed, err := repo.WithTrashed(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("WithTrashed failed: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected WithTrashed to return the soft-deleted Account, got %d results", len(trashed))
+	}
+
+	if err := repo.Restore(ctx, account.ID); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	restored, err := repo.Get(ctx, account.ID)
+	if err != nil {
+		t.Fatalf("expected Get to succeed after Restore: %v", err)
+	}
+	if restored.Name != "Acme Corp" {
+		t.Errorf("Name after restore: got %q, want %q", restored.Name, "Acme Corp")
+	}
+}
+
+func TestLeadRepository_DeleteStampsDeletedBy(t *testing.T) {
+	repo := NewLeadRepository(openTestDB(t))
+	ctx := models.WithCurrentUser(context.Background(), 99)
+
+	lead := &models.Lead{FirstName: "Katherine", LastName: "Johnson"}
+	if err := repo.Create(ctx, lead); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Delete(ctx, lead.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	trashed, err := repo.WithTrashed(ctx, Filter{})
+	if err != nil {
+		t.Fatalf("WithTrashed failed: %v", err)
+	}
+	if len(trashed) != 1 {
+		t.Fatalf("expected WithTrashed to return the soft-deleted Lead, got %d results", len(trashed))
+	}
+	if trashed[0].DeletedBy != 99 {
+		t.Errorf("DeletedBy: got %d, want %d", trashed[0].DeletedBy, 99)
+	}
+}
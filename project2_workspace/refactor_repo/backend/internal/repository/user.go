@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"backend/internal/models"
+)
+
+// UserRepository provides validated, soft-delete-aware access to User rows.
+type UserRepository struct {
+	crud
+}
+
+// NewUserRepository returns a UserRepository backed by db.
+func NewUserRepository(db *gorm.DB) *UserRepository {
+	return &UserRepository{crud: crud{db: db}}
+}
+
+// Create validates user's sized fields and inserts it.
+func (r *UserRepository) Create(ctx context.Context, user *models.User) error {
+	return r.create(ctx, user)
+}
+
+// Get loads the non-deleted User with the given id.
+func (r *UserRepository) Get(ctx context.Context, id uint) (*models.User, error) {
+	var user models.User
+	if err := r.get(ctx, &user, id); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// Update validates user's sized fields and saves it.
+func (r *UserRepository) Update(ctx context.Context, user *models.User) error {
+	return r.update(ctx, user)
+}
+
+// Delete soft-deletes the User with the given id.
+func (r *UserRepository) Delete(ctx context.Context, id uint) error {
+	return r.delete(ctx, &models.User{}, id)
+}
+
+// List returns non-deleted Users matching f. Only Owner, CreatedAfter,
+// CreatedBefore, Cursor, and Limit apply to User; it has no status or
+// rating column.
+func (r *UserRepository) List(ctx context.Context, f Filter) ([]models.User, error) {
+	var users []models.User
+	if err := r.list(ctx, &users, f); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// WithTrashed returns Users matching f, including soft-deleted ones.
+func (r *UserRepository) WithTrashed(ctx context.Context, f Filter) ([]models.User, error) {
+	var users []models.User
+	if err := r.listWithTrashed(ctx, &users, f); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// Restore clears DeletedAt on the soft-deleted User with the given id.
+func (r *UserRepository) Restore(ctx context.Context, id uint) error {
+	return r.restore(ctx, &models.User{}, id)
+}
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"math/big"
 	"testing"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // TestCampaignStruct serves as a placeholder and smoke test for the Campaign model.
@@ -25,9 +26,9 @@ import (
 // It acts as a compile-time and basic initialization check.
 func TestCampaignStruct(t *testing.T) {
 	now := time.Now()
-	budget, _ := new(big.Float).SetString("12345.67")
-	targetRevenue, _ := new(big.Float).SetString("50000.00")
-	revenue, _ := new(big.Float).SetString("1000.50")
+	budget, _ := ParseMoney("12345.67")
+	targetRevenue, _ := ParseMoney("50000.00")
+	revenue, _ := ParseMoney("1000.50")
 	startsOn := now.AddDate(0, 0, -10)
 	endsOn := now.AddDate(0, 1, 0)
 	deletedAt := now.AddDate(0, 0, 1) // Just for instantiation
@@ -39,20 +40,22 @@ func TestCampaignStruct(t *testing.T) {
 		Name:               "Q4 Marketing Push",
 		Access:             "Public",
 		Status:             "Active",
-		Budget:             budget,
+		Budget:             &budget,
 		TargetLeads:        500,
 		TargetConversion:   10.5,
-		TargetRevenue:      targetRevenue,
+		TargetRevenue:      &targetRevenue,
 		LeadsCount:         25,
 		OpportunitiesCount: 5,
-		Revenue:            revenue,
+		Revenue:            &revenue,
 		StartsOn:           &startsOn,
 		EndsOn:             &endsOn,
-		Objectives:         "Increase brand awareness and generate new leads.",
-		DeletedAt:          &deletedAt,
-		CreatedAt:          now,
-		UpdatedAt:          now,
-		BackgroundInfo:     "Annual campaign targeting enterprise clients.",
+		Objectives: "Increase brand awareness and generate new leads.",
+		Auditable: Auditable{
+			DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		BackgroundInfo: "Annual campaign targeting enterprise clients.",
 	}
 
 	if c.ID != 1 {
@@ -63,7 +66,7 @@ func TestCampaignStruct(t *testing.T) {
 		t.Errorf("Expected Name to be 'Q4 Marketing Push', but got '%s'", c.Name)
 	}
 
-	if c.Budget.Cmp(budget) != 0 {
+	if !c.Budget.Equal(budget.Decimal) {
 		t.Errorf("Expected Budget to be %s, but got %s", budget.String(), c.Budget.String())
 	}
 
@@ -54,8 +54,8 @@ func TestContactStructInitialization(t *testing.T) {
 	if contact.BornOn != nil {
 		t.Errorf("Expected BornOn to be nil, but got %v", contact.BornOn)
 	}
-	if contact.DeletedAt != nil {
-		t.Errorf("Expected DeletedAt to be nil, but got %v", contact.DeletedAt)
+	if contact.DeletedAt.Valid {
+		t.Errorf("Expected DeletedAt to be invalid (not soft-deleted), but got %v", contact.DeletedAt)
 	}
 
 	// Test that time.Time fields initialize to their Go zero value
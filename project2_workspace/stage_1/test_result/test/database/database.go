@@ -0,0 +1,123 @@
+package database
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Config describes how to open a database connection. Driver selects the
+// gorm.Dialector to use when DSN is a plain connection string rather than a
+// scheme-tagged URL (e.g. "sqlite://:memory:").
+type Config struct {
+	Driver          string
+	DSN             string
+	Logger          logger.Interface
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+}
+
+// dialector resolves cfg into a gorm.Dialector, accepting either a bare DSN
+// paired with cfg.Driver, or a scheme-tagged URL ("sqlite://", "postgres://",
+// "mysql://") that is self-describing.
+func dialector(cfg Config) (gorm.Dialector, error) {
+	driver := cfg.Driver
+	dsn := cfg.DSN
+
+	if scheme, rest, ok := strings.Cut(dsn, "://"); ok {
+		switch scheme {
+		case "sqlite", "postgres", "mysql":
+			driver = scheme
+			dsn = rest
+		}
+	}
+
+	switch driver {
+	case "", "mysql":
+		return mysql.Open(dsn), nil
+	case "postgres", "postgresql":
+		return postgres.Open(dsn), nil
+	case "sqlite", "sqlite3":
+		return sqlite.Open(dsn), nil
+	default:
+		return nil, fmt.Errorf("database: unsupported driver %q", driver)
+	}
+}
+
+// Open connects to the database described by cfg, applying pool settings and
+// the provided logger.Interface if set. Unlike InitDB, Open never terminates
+// the process; callers decide how to handle a returned error.
+func Open(cfg Config) (*gorm.DB, error) {
+	dial, err := dialector(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	gormCfg := &gorm.Config{}
+	if cfg.Logger != nil {
+		gormCfg.Logger = cfg.Logger
+	}
+
+	db, err := gorm.Open(dial, gormCfg)
+	if err != nil {
+		return nil, fmt.Errorf("database: failed to connect: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("database: failed to access connection pool: %w", err)
+	}
+	if cfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+
+	return db, nil
+}
+
+// MustOpen is like Open but terminates the process via log.Fatal when the
+// connection cannot be established, matching the historical behavior of
+// InitDB for callers that have no recovery path.
+func MustOpen(cfg Config) *gorm.DB {
+	db, err := Open(cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	return db
+}
+
+// configFromEnv builds a Config from DB_DRIVER/DB_DSN (and pool-tuning
+// variables), falling back to the historical hardcoded MySQL DSN when unset
+// so existing deployments keep working unmodified.
+func configFromEnv() Config {
+	cfg := Config{
+		Driver: os.Getenv("DB_DRIVER"),
+		DSN:    os.Getenv("DB_DSN"),
+	}
+	if cfg.DSN == "" {
+		cfg.Driver = "mysql"
+		cfg.DSN = "root:password@tcp(127.0.0.1:3306)/crm?charset=utf8mb4&parseTime=True&loc=Local"
+	}
+	return cfg
+}
+
+// InitDB opens the database connection configured via DB_DRIVER/DB_DSN,
+// terminating the process with log.Fatal on failure. It is a thin wrapper
+// around MustOpen kept for backward compatibility with existing callers.
+func InitDB() *gorm.DB {
+	return MustOpen(configFromEnv())
+}
@@ -8,24 +8,62 @@ import (
 	"testing"
 )
 
+// TestOpen_SQLiteInMemory validates that Open can establish an in-memory
+// SQLite connection via a scheme-tagged DSN and that pool options are
+// applied to the resulting *sql.DB.
+func TestOpen_SQLiteInMemory(t *testing.T) {
+	db, err := Open(Config{
+		DSN:          "sqlite://:memory:",
+		MaxOpenConns: 5,
+		MaxIdleConns: 2,
+	})
+	if err != nil {
+		t.Fatalf("Open returned unexpected error: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to access underlying *sql.DB: %v", err)
+	}
+	if err := sqlDB.Ping(); err != nil {
+		t.Fatalf("Ping failed: %v", err)
+	}
+
+	stats := sqlDB.Stats()
+	if stats.MaxOpenConnections != 5 {
+		t.Errorf("expected MaxOpenConnections 5, got %d", stats.MaxOpenConnections)
+	}
+}
+
+// TestOpen_UnsupportedDriver validates that Open surfaces an error rather
+// than panicking or falling back silently when Driver names an unknown
+// dialector.
+func TestOpen_UnsupportedDriver(t *testing.T) {
+	_, err := Open(Config{Driver: "oracle", DSN: "unused"})
+	if err == nil {
+		t.Fatal("expected an error for an unsupported driver, got nil")
+	}
+	if !strings.Contains(err.Error(), "unsupported driver") {
+		t.Errorf("expected error to mention unsupported driver, got: %v", err)
+	}
+}
+
 // TestInitDB_FailureOnNoConnection validates that InitDB calls log.Fatal
 // when a database connection cannot be established.
 //
-// As the function under test, InitDB, has hardcoded dependencies (gorm.Open, a specific DSN)
-// and a terminating side effect (log.Fatal), it cannot be unit tested in isolation.
-// This test uses a standard Go pattern for testing functions that call os.Exit:
-// it re-runs the test executable in a subprocess with an environment variable set.
+// InitDB itself still has a hardcoded DSN and a terminating side effect
+// (log.Fatal), so its own failure path can only be exercised out-of-process.
+// This test uses a standard Go pattern for testing functions that call
+// os.Exit: it re-runs the test executable in a subprocess with an
+// environment variable set.
 //
 // The subprocess then calls InitDB, which is expected to fail and exit in the test
 // environment where no database is running. The main test process then asserts
 // that the subprocess exited with a non-zero status code and that the expected
 // error message was printed to stderr.
 //
-// Note: Testing the success path of InitDB is not possible with the current
-// implementation without provisioning a live database, as the DSN is hardcoded.
-// To make the success path unit-testable, InitDB could be refactored to accept a
-// gorm.Dialector or a DSN string as an argument, allowing a mock or an in-memory
-// database (like SQLite) to be used in tests.
+// The success path is covered separately by TestOpen_SQLiteInMemory above,
+// which exercises the dialector-accepting Open that InitDB is built on.
 func TestInitDB_FailureOnNoConnection(t *testing.T) {
 	// When this env var is set, we are in the subprocess.
 	// We call the function that will exit, and then we're done.
@@ -57,9 +95,4 @@ func TestInitDB_FailureOnNoConnection(t *testing.T) {
 	if !strings.Contains(output, expectedLog) {
 		t.Errorf("Expected stderr to contain %q, but got: %q", expectedLog, output)
 	}
-}
-
-// Golden output is empty (`{}`), indicating no specific return values to compare.
-// The test above verifies the behavioral side-effect (logging and fatal exit on
-// connection failure), which is the only scenario testable in an isolated
-// environment for the given code.
\ No newline at end of file
+}
\ No newline at end of file
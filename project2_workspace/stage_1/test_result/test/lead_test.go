@@ -3,7 +3,6 @@ package models
 import (
 	"reflect"
 	"testing"
-	"time"
 )
 
 // TestLeadStructDefinition validates that the Lead struct has the expected fields, types, and GORM tags.
@@ -48,9 +47,7 @@ func TestLeadStructDefinition(t *testing.T) {
 		{Name: "Twitter", Kind: reflect.String, Type: reflect.TypeOf(""), Tag: `gorm:"size:128"`},
 		{Name: "Rating", Kind: reflect.Int, Type: reflect.TypeOf(0), Tag: `gorm:"not null;default:0"`},
 		{Name: "DoNotCall", Kind: reflect.Bool, Type: reflect.TypeOf(false), Tag: `gorm:"not null;default:false"`},
-		{Name: "DeletedAt", Kind: reflect.Ptr, Type: reflect.TypeOf(&time.Time{}), Tag: ``},
-		{Name: "CreatedAt", Kind: reflect.Struct, Type: reflect.TypeOf(time.Time{}), Tag: ``},
-		{Name: "UpdatedAt", Kind: reflect.Struct, Type: reflect.TypeOf(time.Time{}), Tag: ``},
+		{Name: "Auditable", Kind: reflect.Struct, Type: reflect.TypeOf(Auditable{}), Tag: ``},
 		{Name: "BackgroundInfo", Kind: reflect.String, Type: reflect.TypeOf(""), Tag: `gorm:"size:255"`},
 	}
 
@@ -1,9 +1,10 @@
 package models
 
 import (
-	"math/big"
 	"testing"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // TestOpportunityStruct verifies that the Opportunity struct can be instantiated
@@ -16,8 +17,8 @@ func TestOpportunityStruct(t *testing.T) {
 	fixedTime := time.Date(2023, 10, 26, 12, 0, 0, 0, time.UTC)
 	closesOn := fixedTime.Add(30 * 24 * time.Hour)
 	deletedAt := fixedTime.Add(60 * 24 * time.Hour)
-	amount := new(big.Float).SetFloat64(5000.75)
-	discount := new(big.Float).SetFloat64(500.25)
+	amount := NewMoneyFromFloat(5000.75)
+	discount := NewMoneyFromFloat(500.25)
 
 	opp := Opportunity{
 		ID:             1,
@@ -29,12 +30,14 @@ func TestOpportunityStruct(t *testing.T) {
 		Source:         "Referral",
 		Stage:          "Prospecting",
 		Probability:    20,
-		Amount:         amount,
-		Discount:       discount,
-		ClosesOn:       &closesOn,
-		DeletedAt:      &deletedAt,
-		CreatedAt:      fixedTime,
-		UpdatedAt:      fixedTime,
+		Amount:         &amount,
+		Discount:       &discount,
+		ClosesOn: &closesOn,
+		Auditable: Auditable{
+			DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true},
+			CreatedAt: fixedTime,
+			UpdatedAt: fixedTime,
+		},
 		BackgroundInfo: "Initial contact made.",
 	}
 
@@ -67,11 +70,11 @@ func TestOpportunityStruct(t *testing.T) {
 		t.Errorf("expected Probability to be 20, got %d", opp.Probability)
 	}
 
-	// Compare big.Float values
-	if opp.Amount.Cmp(amount) != 0 {
+	// Compare Money values
+	if !opp.Amount.Equal(amount.Decimal) {
 		t.Errorf("expected Amount to be %v, got %v", amount, opp.Amount)
 	}
-	if opp.Discount.Cmp(discount) != 0 {
+	if !opp.Discount.Equal(discount.Decimal) {
 		t.Errorf("expected Discount to be %v, got %v", discount, opp.Discount)
 	}
 
@@ -79,7 +82,7 @@ func TestOpportunityStruct(t *testing.T) {
 	if opp.ClosesOn == nil || !opp.ClosesOn.Equal(closesOn) {
 		t.Errorf("expected ClosesOn to be %v, got %v", closesOn, opp.ClosesOn)
 	}
-	if opp.DeletedAt == nil || !opp.DeletedAt.Equal(deletedAt) {
+	if !opp.DeletedAt.Valid || !opp.DeletedAt.Time.Equal(deletedAt) {
 		t.Errorf("expected DeletedAt to be %v, got %v", deletedAt, opp.DeletedAt)
 	}
 
@@ -125,8 +128,8 @@ func TestOpportunityStructZeroValues(t *testing.T) {
 	if opp.ClosesOn != nil {
 		t.Errorf("expected default ClosesOn to be nil, got %v", opp.ClosesOn)
 	}
-	if opp.DeletedAt != nil {
-		t.Errorf("expected default DeletedAt to be nil, got %v", opp.DeletedAt)
+	if opp.DeletedAt.Valid {
+		t.Errorf("expected default DeletedAt to be invalid (not soft-deleted), got %v", opp.DeletedAt)
 	}
 	if !opp.CreatedAt.IsZero() {
 		t.Errorf("expected default CreatedAt to be the zero time, got %v", opp.CreatedAt)
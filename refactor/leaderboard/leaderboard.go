@@ -1,4 +1,3 @@
-
 package leaderboard
 
 import (
@@ -6,7 +5,10 @@ import (
 	"sort"
 )
 
-var points = []int{25, 18, 15}
+// classicPoints is the original fixed top-3 points table used by the
+// deprecated NewRace constructor, preserved exactly so races built through
+// it keep scoring identically to before ScoringSystem existed.
+var classicPoints = []int{25, 18, 15}
 
 type Driver struct {
 	Name    string
@@ -19,44 +21,187 @@ type SelfDrivingCar struct {
 	Company          string
 }
 
+// FinishStatus describes how an entry finished a race.
+type FinishStatus int
+
+const (
+	// Finished is the zero value: the entry completed the race and is
+	// eligible for points at its Position.
+	Finished FinishStatus = iota
+	// DNF marks a did-not-finish entry; it earns zero points regardless of Position.
+	DNF
+	// DSQ marks a disqualified entry; it earns zero points regardless of Position.
+	DSQ
+)
+
+// RaceEntry is a single driver's (or car's) result in a Race. Multiple
+// entries may share the same Position (e.g. a dead heat); their combined
+// points are summed and split evenly between them, per FIA countback rules.
+type RaceEntry struct {
+	Driver     interface{}
+	Position   int
+	Status     FinishStatus
+	FastestLap bool
+}
+
+// ScoringSystem computes each entry's points for a single race.
+type ScoringSystem interface {
+	Score(entries []RaceEntry) map[interface{}]float64
+}
+
+// tableScoring is a ScoringSystem driven by a fixed points-per-position
+// table plus an optional fastest-lap bonus.
+type tableScoring struct {
+	table               []int
+	fastestLapBonus     int
+	bonusRequiresPoints bool
+}
+
+// F1Modern is the scoring system used since the 2010 season: the top 10
+// finishers score 25-18-15-12-10-8-6-4-2-1, with a +1 bonus for the fastest
+// lap if (and only if) that driver finishes in the top 10.
+var F1Modern ScoringSystem = tableScoring{
+	table:               []int{25, 18, 15, 12, 10, 8, 6, 4, 2, 1},
+	fastestLapBonus:     1,
+	bonusRequiresPoints: true,
+}
+
+// F1Sprint is the scoring system used for sprint races: the top 8 finishers
+// score 8-7-6-5-4-3-2-1, with no fastest-lap bonus.
+var F1Sprint ScoringSystem = tableScoring{
+	table: []int{8, 7, 6, 5, 4, 3, 2, 1},
+}
+
+// Custom builds a ScoringSystem from an arbitrary points-per-position table
+// and fastest-lap bonus (awarded regardless of finishing position).
+func Custom(points []int, fastestLapBonus int) ScoringSystem {
+	return tableScoring{table: points, fastestLapBonus: fastestLapBonus}
+}
+
+func (s tableScoring) Score(entries []RaceEntry) map[interface{}]float64 {
+	scores := make(map[interface{}]float64, len(entries))
+
+	scored := make([]RaceEntry, 0, len(entries))
+	for _, e := range entries {
+		scores[e.Driver] = 0
+		if e.Status == Finished {
+			scored = append(scored, e)
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Position < scored[j].Position
+	})
+
+	for i := 0; i < len(scored); {
+		j := i
+		for j < len(scored) && scored[j].Position == scored[i].Position {
+			j++
+		}
+		group := scored[i:j]
+
+		// A group's table slots start at its own Position, not at a running
+		// count of scored entries seen so far: a DNF/DSQ (or any gap between
+		// finishing positions) must not shift later positions into richer
+		// slots than the table actually assigns them.
+		slot := scored[i].Position - 1
+		var total int
+		for k := 0; k < len(group) && slot+k >= 0 && slot+k < len(s.table); k++ {
+			total += s.table[slot+k]
+		}
+		share := float64(total) / float64(len(group))
+		for _, e := range group {
+			scores[e.Driver] = share
+		}
+
+		i = j
+	}
+
+	if s.fastestLapBonus != 0 {
+		for _, e := range entries {
+			if !e.FastestLap {
+				continue
+			}
+			if s.bonusRequiresPoints && scores[e.Driver] == 0 {
+				continue
+			}
+			scores[e.Driver] += float64(s.fastestLapBonus)
+		}
+	}
+
+	return scores
+}
+
+// Race is a single race: its Scoring determines how Results translate into
+// points.
 type Race struct {
 	Name        string
-	Results     []interface{}
+	Results     []RaceEntry
+	Scoring     ScoringSystem
 	DriverNames map[interface{}]string
 }
 
-func NewRace(name string, results []interface{}) *Race {
+// NewScoredRace creates a Race whose points are computed by scoring.
+func NewScoredRace(name string, results []RaceEntry, scoring ScoringSystem) *Race {
 	r := &Race{
 		Name:        name,
 		Results:     results,
-		DriverNames: make(map[interface{}]string),
+		Scoring:     scoring,
+		DriverNames: make(map[interface{}]string, len(results)),
 	}
-	for _, driver := range results {
-		switch d := driver.(type) {
-		case Driver:
-			r.DriverNames[driver] = d.Name
-		case SelfDrivingCar:
-			r.DriverNames[driver] = fmt.Sprintf("Self Driving Car - %s (%s)", d.Company, d.AlgorithmVersion)
-		}
+	for _, entry := range results {
+		r.DriverNames[entry.Driver] = driverName(entry.Driver)
 	}
 	return r
 }
 
-func (r *Race) Points(driver interface{}) int {
-	for i, d := range r.Results {
-		if d == driver {
-			if i < len(points) {
-				return points[i]
-			}
-		}
+// NewRace creates a Race from an ordered finishing list, scored with the
+// original fixed top-3 table (25-18-15, 0 thereafter).
+//
+// Deprecated: use NewScoredRace with an explicit ScoringSystem (e.g.
+// F1Modern) instead; this constructor exists so races built before
+// ScoringSystem existed keep scoring identically.
+func NewRace(name string, results []interface{}) *Race {
+	entries := make([]RaceEntry, len(results))
+	for i, driver := range results {
+		entries[i] = RaceEntry{Driver: driver, Position: i + 1, Status: Finished}
+	}
+	return NewScoredRace(name, entries, Custom(classicPoints, 0))
+}
+
+func driverName(driver interface{}) string {
+	switch d := driver.(type) {
+	case Driver:
+		return d.Name
+	case SelfDrivingCar:
+		return fmt.Sprintf("Self Driving Car - %s (%s)", d.Company, d.AlgorithmVersion)
+	default:
+		return ""
 	}
-	return 0
 }
 
+// Points returns driver's points in this race.
+//
+// Deprecated: use Scoring.Score(r.Results) to get every entry's points at
+// once, including split/tied positions.
+func (r *Race) Points(driver interface{}) int {
+	return int(r.Scoring.Score(r.Results)[driver])
+}
+
+// DriverName returns the display name associated with driver in this race.
 func (r *Race) DriverName(driver interface{}) string {
 	return r.DriverNames[driver]
 }
 
+// Ranking is a driver's standing across a Leaderboard, with the countback
+// stats (Wins, then Podiums) used to break point ties.
+type Ranking struct {
+	Name    string
+	Points  float64
+	Wins    int
+	Podiums int
+}
+
 type Leaderboard struct {
 	Races []*Race
 }
@@ -65,34 +210,106 @@ func NewLeaderboard(races []*Race) *Leaderboard {
 	return &Leaderboard{Races: races}
 }
 
+// Standings returns every driver's accumulated points, wins, and podiums
+// across all races, sorted by points, then wins, then podiums (the FIA
+// countback rule).
+func (l *Leaderboard) Standings() []Ranking {
+	type accumulator struct {
+		points  float64
+		wins    int
+		podiums int
+	}
+	stats := make(map[string]*accumulator)
+	order := make([]string, 0)
+
+	for _, race := range l.Races {
+		scores := race.Scoring.Score(race.Results)
+		for _, entry := range race.Results {
+			name := race.DriverName(entry.Driver)
+			acc, ok := stats[name]
+			if !ok {
+				acc = &accumulator{}
+				stats[name] = acc
+				order = append(order, name)
+			}
+			acc.points += scores[entry.Driver]
+			if entry.Status == Finished && entry.Position == 1 {
+				acc.wins++
+			}
+			if entry.Status == Finished && entry.Position >= 1 && entry.Position <= 3 {
+				acc.podiums++
+			}
+		}
+	}
+
+	rankings := make([]Ranking, 0, len(order))
+	for _, name := range order {
+		acc := stats[name]
+		rankings = append(rankings, Ranking{Name: name, Points: acc.points, Wins: acc.wins, Podiums: acc.podiums})
+	}
+
+	sort.SliceStable(rankings, func(i, j int) bool {
+		if rankings[i].Points != rankings[j].Points {
+			return rankings[i].Points > rankings[j].Points
+		}
+		if rankings[i].Wins != rankings[j].Wins {
+			return rankings[i].Wins > rankings[j].Wins
+		}
+		return rankings[i].Podiums > rankings[j].Podiums
+	})
+
+	return rankings
+}
+
+// DriverPoints returns each driver's total points across all races.
+//
+// Deprecated: use Standings, which also reports wins/podiums for countback.
 func (l *Leaderboard) DriverPoints() map[string]int {
 	driverPoints := make(map[string]int)
 	for _, race := range l.Races {
-		for _, driver := range race.Results {
-			name := race.DriverName(driver)
-			driverPoints[name] += race.Points(driver)
+		scores := race.Scoring.Score(race.Results)
+		for _, entry := range race.Results {
+			name := race.DriverName(entry.Driver)
+			driverPoints[name] += int(scores[entry.Driver])
 		}
 	}
 	return driverPoints
 }
 
-type driverRanking struct {
-	Name   string
-	Points int
-}
-
+// DriverRankings returns driver names ordered by total points, highest
+// first. Drivers tied on points keep the order they first appeared in
+// across l.Races, rather than the random order map iteration would give.
+//
+// Deprecated: use Standings, which breaks ties by wins then podiums instead
+// of first-appearance order.
 func (l *Leaderboard) DriverRankings() []string {
-	driverPoints := l.DriverPoints()
-	rankings := make([]driverRanking, 0, len(driverPoints))
-	for name, points := range driverPoints {
-		rankings = append(rankings, driverRanking{Name: name, Points: points})
+	type driverRanking struct {
+		Name   string
+		Points int
+	}
+	points := make(map[string]int)
+	order := make([]string, 0)
+	for _, race := range l.Races {
+		scores := race.Scoring.Score(race.Results)
+		for _, entry := range race.Results {
+			name := race.DriverName(entry.Driver)
+			if _, ok := points[name]; !ok {
+				order = append(order, name)
+			}
+			points[name] += int(scores[entry.Driver])
+		}
+	}
+
+	rankings := make([]driverRanking, 0, len(order))
+	for _, name := range order {
+		rankings = append(rankings, driverRanking{Name: name, Points: points[name]})
 	}
 
-	sort.Slice(rankings, func(i, j int) bool {
+	sort.SliceStable(rankings, func(i, j int) bool {
 		return rankings[i].Points > rankings[j].Points
 	})
 
-	var rankedNames []string
+	rankedNames := make([]string, 0, len(rankings))
 	for _, ranking := range rankings {
 		rankedNames = append(rankedNames, ranking.Name)
 	}
@@ -0,0 +1,148 @@
+package leaderboard
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTableScoring_F1Modern(t *testing.T) {
+	d1 := Driver{Name: "P1"}
+	d2 := Driver{Name: "P2"}
+	d11 := Driver{Name: "P11"}
+	dDNF := Driver{Name: "DNF"}
+
+	entries := []RaceEntry{
+		{Driver: d1, Position: 1, FastestLap: true},
+		{Driver: d2, Position: 2},
+		{Driver: d11, Position: 11},
+		{Driver: dDNF, Position: 5, Status: DNF},
+	}
+
+	scores := F1Modern.Score(entries)
+
+	if got := scores[d1]; got != 26 {
+		t.Errorf("P1 with fastest lap: got %v, want 26", got)
+	}
+	if got := scores[d2]; got != 18 {
+		t.Errorf("P2: got %v, want 18", got)
+	}
+	if got := scores[d11]; got != 0 {
+		t.Errorf("P11 (outside points): got %v, want 0", got)
+	}
+	if got := scores[dDNF]; got != 0 {
+		t.Errorf("DNF: got %v, want 0", got)
+	}
+}
+
+func TestTableScoring_FastestLapRequiresPoints(t *testing.T) {
+	outOfPoints := Driver{Name: "P11"}
+	entries := []RaceEntry{
+		{Driver: Driver{Name: "P1"}, Position: 1},
+		{Driver: outOfPoints, Position: 11, FastestLap: true},
+	}
+
+	scores := F1Modern.Score(entries)
+	if got := scores[outOfPoints]; got != 0 {
+		t.Errorf("fastest lap outside the top 10 should not score a bonus: got %v, want 0", got)
+	}
+}
+
+func TestTableScoring_TiedPositionSplitsPoints(t *testing.T) {
+	a := Driver{Name: "A"}
+	b := Driver{Name: "B"}
+	c := Driver{Name: "C"}
+
+	entries := []RaceEntry{
+		{Driver: a, Position: 1},
+		{Driver: b, Position: 1},
+		{Driver: c, Position: 3},
+	}
+
+	scores := F1Modern.Score(entries)
+
+	// A and B share positions 1 and 2 (25 + 18 = 43, split two ways = 21.5 each).
+	if got := scores[a]; got != 21.5 {
+		t.Errorf("tied A: got %v, want 21.5", got)
+	}
+	if got := scores[b]; got != 21.5 {
+		t.Errorf("tied B: got %v, want 21.5", got)
+	}
+	// C occupies the next slot (position 3 in the table) despite its own
+	// Position field reading 3, since the two-way tie consumed slots 1 and 2.
+	if got := scores[c]; got != 15 {
+		t.Errorf("C after tie: got %v, want 15", got)
+	}
+}
+
+func TestCustom(t *testing.T) {
+	scoring := Custom([]int{10, 5}, 2)
+	winner := Driver{Name: "Winner"}
+	entries := []RaceEntry{
+		{Driver: winner, Position: 1, FastestLap: true},
+		{Driver: Driver{Name: "Second"}, Position: 2},
+	}
+
+	scores := scoring.Score(entries)
+	if got := scores[winner]; got != 12 {
+		t.Errorf("custom scoring with unconditional bonus: got %v, want 12", got)
+	}
+}
+
+func TestLeaderboard_Standings_BreaksTiesByWinsThenPodiums(t *testing.T) {
+	oneWin := Driver{Name: "OneWin"}
+	twoPodiums := Driver{Name: "TwoPodiums"}
+	scoring := Custom([]int{20, 10, 5}, 0)
+
+	// oneWin takes the win in race 1 (20pts) and scores nothing in race 2.
+	// twoPodiums finishes 2nd in both races (10+10=20pts): equal points, but
+	// fewer wins and more podium finishes than oneWin.
+	race1 := NewScoredRace("Race 1", []RaceEntry{
+		{Driver: oneWin, Position: 1},
+		{Driver: twoPodiums, Position: 2},
+	}, scoring)
+	race2 := NewScoredRace("Race 2", []RaceEntry{
+		{Driver: oneWin, Position: 11},
+		{Driver: twoPodiums, Position: 2},
+	}, scoring)
+
+	standings := NewLeaderboard([]*Race{race1, race2}).Standings()
+
+	if len(standings) != 2 {
+		t.Fatalf("expected 2 standings, got %d", len(standings))
+	}
+	if standings[0].Points != 20 || standings[1].Points != 20 {
+		t.Fatalf("expected both drivers tied on 20 points, got %v and %v", standings[0].Points, standings[1].Points)
+	}
+	if standings[0].Name != "OneWin" {
+		t.Errorf("expected OneWin to rank first on the wins countback despite fewer podiums, got %q", standings[0].Name)
+	}
+	if standings[1].Podiums <= standings[0].Podiums {
+		t.Errorf("expected the runner-up to have more podiums, illustrating the countback matters: got %+v then %+v", standings[0], standings[1])
+	}
+}
+
+func TestLeaderboard_Standings_WinsAndPodiumsCounted(t *testing.T) {
+	winner := Driver{Name: "Winner"}
+	runnerUp := Driver{Name: "RunnerUp"}
+
+	race := NewScoredRace("Race", []RaceEntry{
+		{Driver: winner, Position: 1},
+		{Driver: runnerUp, Position: 2},
+	}, F1Modern)
+
+	standings := NewLeaderboard([]*Race{race}).Standings()
+
+	expectedByName := map[string]Ranking{
+		"Winner":   {Name: "Winner", Points: 25, Wins: 1, Podiums: 1},
+		"RunnerUp": {Name: "RunnerUp", Points: 18, Wins: 0, Podiums: 1},
+	}
+	for _, s := range standings {
+		want, ok := expectedByName[s.Name]
+		if !ok {
+			t.Fatalf("unexpected driver in standings: %q", s.Name)
+		}
+		if !reflect.DeepEqual(s, want) {
+			t.Errorf("standing mismatch for %q: got %+v, want %+v", s.Name, s, want)
+		}
+	}
+}
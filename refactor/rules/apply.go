@@ -0,0 +1,159 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SourceColumn is one ActiveRecord column on a SourceModel.
+type SourceColumn struct {
+	Name string
+	Type string // Rails column type, e.g. "string", "decimal"
+}
+
+// SourceAssociation is one ActiveRecord association (has_many, belongs_to,
+// ...) on a SourceModel.
+type SourceAssociation struct {
+	Kind string // "has_many", "belongs_to", ...
+	Name string
+}
+
+// SourceMethod is one Ruby instance method defined on a SourceModel.
+type SourceMethod struct {
+	Name string
+}
+
+// SourceModel is a single ActiveRecord model.
+type SourceModel struct {
+	Name         string
+	Columns      []SourceColumn
+	Associations []SourceAssociation
+	Methods      []SourceMethod
+}
+
+// SourceAST is the parsed form of a Rails source file: the set of models it
+// defines.
+type SourceAST struct {
+	Models []SourceModel
+}
+
+// GoField is a single Go struct field produced from a SourceColumn.
+type GoField struct {
+	Name    string
+	Type    string
+	GormTag string
+}
+
+// GoPreloadHelper is a generated `Preload<Name>` helper produced from a
+// SourceAssociation, mirroring the `Preload("Name")` call GORM needs to
+// eager-load it.
+type GoPreloadHelper struct {
+	Name       string
+	ForeignKey string
+}
+
+// GoMethodStub is a placeholder method produced from a SourceMethod that
+// Apply could not translate, carrying a TODO for a human to fill in.
+type GoMethodStub struct {
+	Name string
+	TODO string
+}
+
+// GoStruct is the Go representation of a SourceModel after rules have been
+// applied to it.
+type GoStruct struct {
+	Name           string
+	Fields         []GoField
+	PreloadHelpers []GoPreloadHelper
+	MethodStubs    []GoMethodStub
+}
+
+// GoAST is the output of Apply: one GoStruct per SourceModel.
+type GoAST struct {
+	Structs []GoStruct
+}
+
+// Report summarizes an Apply run: how many source constructs were
+// translated, and which ones had no matching rule and were left out of the
+// GoAST entirely.
+type Report struct {
+	Applied int
+	Skipped []string
+}
+
+func (r *Report) skip(format string, args ...interface{}) {
+	r.Skipped = append(r.Skipped, fmt.Sprintf(format, args...))
+}
+
+// Apply translates src into a GoAST by matching each column, association,
+// and method against rs, in that order. A source construct with no matching
+// rule (and no applicable "*" wildcard rule) is omitted from the GoAST and
+// recorded in the returned Report instead of causing Apply to fail, so one
+// missing rule doesn't block translating the rest of the model.
+func Apply(src SourceAST, rs *RuleSet) (GoAST, Report) {
+	var out GoAST
+	var report Report
+
+	for _, model := range src.Models {
+		gs := GoStruct{Name: model.Name}
+
+		for _, col := range model.Columns {
+			rule, ok := rs.match(KindColumn, col.Type)
+			if !ok {
+				report.skip("%s.%s: no column rule for Rails type %q", model.Name, col.Name, col.Type)
+				continue
+			}
+			gs.Fields = append(gs.Fields, GoField{
+				Name:    pascalCase(col.Name),
+				Type:    rule.GoType,
+				GormTag: rule.GormTag,
+			})
+			report.Applied++
+		}
+
+		for _, assoc := range model.Associations {
+			rule, ok := rs.match(KindHasMany, assoc.Kind)
+			if !ok {
+				report.skip("%s.%s: no association rule for %q", model.Name, assoc.Name, assoc.Kind)
+				continue
+			}
+			gs.PreloadHelpers = append(gs.PreloadHelpers, GoPreloadHelper{
+				Name:       pascalCase(assoc.Name),
+				ForeignKey: rule.ForeignKey,
+			})
+			report.Applied++
+		}
+
+		for _, method := range model.Methods {
+			rule, ok := rs.match(KindMethodStub, method.Name)
+			if !ok {
+				report.skip("%s.%s: no method_stub rule", model.Name, method.Name)
+				continue
+			}
+			gs.MethodStubs = append(gs.MethodStubs, GoMethodStub{
+				Name: pascalCase(method.Name),
+				TODO: rule.TODO,
+			})
+			report.Applied++
+		}
+
+		out.Structs = append(out.Structs, gs)
+	}
+
+	return out, report
+}
+
+// pascalCase converts a snake_case Ruby identifier (e.g. "first_name",
+// "has_many") into the PascalCase Go convention (e.g. "FirstName").
+func pascalCase(name string) string {
+	parts := strings.Split(name, "_")
+	var b strings.Builder
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]))
+		b.WriteString(part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,153 @@
+// Package rules loads a declarative rule set describing how constructs in a
+// source language (Rails ActiveRecord models, in this agent's case) map onto
+// Go, and applies that rule set to a parsed source AST to produce a Go AST.
+// Adding support for a new language pair is then a matter of authoring a new
+// rule file, not recompiling the agent.
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleKind identifies which source construct a Rule maps from.
+type RuleKind string
+
+const (
+	// KindColumn maps an ActiveRecord column's type to a Go struct field.
+	KindColumn RuleKind = "column"
+	// KindHasMany maps an ActiveRecord has_many/belongs_to association to a
+	// Go foreign key field plus a preload helper.
+	KindHasMany RuleKind = "association"
+	// KindMethodStub maps a Ruby instance method to a Go method stub
+	// carrying a TODO, for methods the agent doesn't yet translate.
+	KindMethodStub RuleKind = "method_stub"
+)
+
+// Rule is a single source-construct-to-Go mapping. Which fields are
+// meaningful depends on Kind: GoType/GormTag for KindColumn, ForeignKey for
+// KindHasMany, TODO for KindMethodStub.
+type Rule struct {
+	Kind RuleKind `json:"kind"`
+	// Match is the source-side key this rule applies to: a Rails column
+	// type ("string", "decimal", ...) for KindColumn, an association name
+	// for KindHasMany, or a method name for KindMethodStub. "*" matches
+	// anything not matched by a more specific rule.
+	Match string `json:"match"`
+
+	GoType  string `json:"go_type,omitempty"`
+	GormTag string `json:"gorm_tag,omitempty"`
+
+	ForeignKey string `json:"foreign_key,omitempty"`
+
+	TODO string `json:"todo,omitempty"`
+}
+
+// RuleSet is the canonical in-memory representation of a rule file,
+// regardless of whether it was authored as JSON or YAML.
+type RuleSet struct {
+	Version string `json:"version"`
+	Rules   []Rule `json:"rules"`
+}
+
+// Load reads the rule file at path and decodes it into a RuleSet. Files
+// ending in .yaml or .yml are first converted to their canonical JSON form
+// (via an intermediate generic decode/re-encode) and then unmarshaled
+// exactly as a .json file would be, so the two input formats always produce
+// identical RuleSets for equivalent content.
+func Load(path string) (*RuleSet, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("rules: reading %s: %w", path, err)
+	}
+
+	jsonBytes, err := toCanonicalJSON(path, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var rs RuleSet
+	if err := json.Unmarshal(jsonBytes, &rs); err != nil {
+		return nil, fmt.Errorf("rules: decoding %s: %w", path, err)
+	}
+	return &rs, nil
+}
+
+// toCanonicalJSON returns raw unchanged if path looks like JSON, and
+// otherwise parses it as YAML and re-encodes the result as JSON.
+func toCanonicalJSON(path string, raw []byte) ([]byte, error) {
+	if !isYAMLPath(path) {
+		return raw, nil
+	}
+
+	var generic interface{}
+	if err := yaml.Unmarshal(raw, &generic); err != nil {
+		return nil, fmt.Errorf("rules: parsing %s as YAML: %w", path, err)
+	}
+	generic = normalizeYAML(generic)
+
+	jsonBytes, err := json.Marshal(generic)
+	if err != nil {
+		return nil, fmt.Errorf("rules: converting %s to JSON: %w", path, err)
+	}
+	return jsonBytes, nil
+}
+
+func isYAMLPath(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".yaml") || strings.HasSuffix(lower, ".yml")
+}
+
+// normalizeYAML recursively converts the map[string]interface{} /
+// map[interface{}]interface{} mix yaml.v3 can produce into the
+// map[string]interface{} json.Marshal requires.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[k] = normalizeYAML(val)
+		}
+		return out
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[fmt.Sprintf("%v", k)] = normalizeYAML(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = normalizeYAML(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// match returns the first rule of the given kind whose Match equals key, or
+// failing that the first wildcard ("*") rule of that kind.
+func (rs *RuleSet) match(kind RuleKind, key string) (Rule, bool) {
+	var wildcard *Rule
+	for i := range rs.Rules {
+		rule := &rs.Rules[i]
+		if rule.Kind != kind {
+			continue
+		}
+		if rule.Match == key {
+			return *rule, true
+		}
+		if rule.Match == "*" && wildcard == nil {
+			wildcard = rule
+		}
+	}
+	if wildcard != nil {
+		return *wildcard, true
+	}
+	return Rule{}, false
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testJSON = `{
+  "version": "1",
+  "rules": [
+    {"kind": "column", "match": "string", "go_type": "string", "gorm_tag": "size:255"},
+    {"kind": "column", "match": "decimal", "go_type": "*Money", "gorm_tag": "type:decimal(12,2)"},
+    {"kind": "column", "match": "*", "go_type": "string"},
+    {"kind": "association", "match": "has_many", "foreign_key": "LeadID"},
+    {"kind": "method_stub", "match": "promote", "todo": "translate Lead#promote"}
+  ]
+}`
+
+const testYAML = `
+version: "1"
+rules:
+  - kind: column
+    match: string
+    go_type: string
+    gorm_tag: "size:255"
+  - kind: column
+    match: decimal
+    go_type: "*Money"
+    gorm_tag: "type:decimal(12,2)"
+  - kind: column
+    match: "*"
+    go_type: string
+  - kind: association
+    match: has_many
+    foreign_key: LeadID
+  - kind: method_stub
+    match: promote
+    todo: "translate Lead#promote"
+`
+
+func TestLoad_YAMLAndJSONProduceIdenticalRuleSets(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "rules.json")
+	yamlPath := filepath.Join(dir, "rules.yaml")
+
+	if err := os.WriteFile(jsonPath, []byte(testJSON), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", jsonPath, err)
+	}
+	if err := os.WriteFile(yamlPath, []byte(testYAML), 0o644); err != nil {
+		t.Fatalf("writing %s: %v", yamlPath, err)
+	}
+
+	fromJSON, err := Load(jsonPath)
+	if err != nil {
+		t.Fatalf("Load(%s): %v", jsonPath, err)
+	}
+	fromYAML, err := Load(yamlPath)
+	if err != nil {
+		t.Fatalf("Load(%s): %v", yamlPath, err)
+	}
+
+	if !reflect.DeepEqual(fromJSON, fromYAML) {
+		t.Errorf("YAML and JSON rule sets differ:\nJSON: %+v\nYAML: %+v", fromJSON, fromYAML)
+	}
+}
+
+func TestApply(t *testing.T) {
+	var rs RuleSet
+	if err := json.Unmarshal([]byte(testJSON), &rs); err != nil {
+		t.Fatalf("decoding rule set: %v", err)
+	}
+
+	src := SourceAST{
+		Models: []SourceModel{
+			{
+				Name: "Lead",
+				Columns: []SourceColumn{
+					{Name: "first_name", Type: "string"},
+					{Name: "balance", Type: "decimal"},
+					{Name: "rating", Type: "integer"}, // falls through to the "*" wildcard
+				},
+				Associations: []SourceAssociation{
+					{Kind: "has_many", Name: "contacts"},
+					{Kind: "has_one", Name: "account"}, // no rule for has_one
+				},
+				Methods: []SourceMethod{
+					{Name: "promote"},
+					{Name: "score"}, // no rule for score
+				},
+			},
+		},
+	}
+
+	goAST, report := Apply(src, &rs)
+
+	if len(goAST.Structs) != 1 {
+		t.Fatalf("expected 1 GoStruct, got %d", len(goAST.Structs))
+	}
+	lead := goAST.Structs[0]
+
+	wantFields := []GoField{
+		{Name: "FirstName", Type: "string", GormTag: "size:255"},
+		{Name: "Balance", Type: "*Money", GormTag: "type:decimal(12,2)"},
+		{Name: "Rating", Type: "string"},
+	}
+	if !reflect.DeepEqual(lead.Fields, wantFields) {
+		t.Errorf("Fields: got %+v, want %+v", lead.Fields, wantFields)
+	}
+
+	wantHelpers := []GoPreloadHelper{{Name: "Contacts", ForeignKey: "LeadID"}}
+	if !reflect.DeepEqual(lead.PreloadHelpers, wantHelpers) {
+		t.Errorf("PreloadHelpers: got %+v, want %+v", lead.PreloadHelpers, wantHelpers)
+	}
+
+	wantStubs := []GoMethodStub{{Name: "Promote", TODO: "translate Lead#promote"}}
+	if !reflect.DeepEqual(lead.MethodStubs, wantStubs) {
+		t.Errorf("MethodStubs: got %+v, want %+v", lead.MethodStubs, wantStubs)
+	}
+
+	if report.Applied != 5 {
+		t.Errorf("Report.Applied: got %d, want 5", report.Applied)
+	}
+	if len(report.Skipped) != 2 {
+		t.Errorf("Report.Skipped: got %d entries, want 2: %v", len(report.Skipped), report.Skipped)
+	}
+}
@@ -0,0 +1,157 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DiagnosticReport is the typed form of the dotted-key-value diagnostic
+// block a device emits in response to DiagnosticMessage (see
+// TelemetryClient.Receive). ParseDiagnosticReport turns the raw text into
+// this struct so callers can consume it without regex scraping.
+type DiagnosticReport struct {
+	LastTXRateMbps    float64 `json:"last_tx_rate_mbps"`
+	HighestTXRateMbps float64 `json:"highest_tx_rate_mbps"`
+	LastRXRateMbps    float64 `json:"last_rx_rate_mbps"`
+	HighestRXRateMbps float64 `json:"highest_rx_rate_mbps"`
+	BitRate           int64   `json:"bit_rate"`
+	WordLen           int     `json:"word_len"`
+	WordsPerFrame     int     `json:"words_per_frame"`
+	BitsPerFrame      int     `json:"bits_per_frame"`
+	ModulationType    string  `json:"modulation_type"`
+	TXDigitalLOS      float64 `json:"tx_digital_los"`
+	RXDigitalLOS      float64 `json:"rx_digital_los"`
+	BEPTest           int     `json:"bep_test"`
+	LocalRtrnCount    int     `json:"local_rtrn_count"`
+	RemoteRtrnCount   int     `json:"remote_rtrn_count"`
+}
+
+// diagnosticField binds a dotted-key-value label to the DiagnosticReport
+// field it populates.
+type diagnosticField struct {
+	label string
+	set   func(r *DiagnosticReport, value string) error
+}
+
+var diagnosticFields = []diagnosticField{
+	{"LAST TX rate", func(r *DiagnosticReport, v string) (err error) { r.LastTXRateMbps, err = parseRateMbps(v); return }},
+	{"HIGHEST TX rate", func(r *DiagnosticReport, v string) (err error) { r.HighestTXRateMbps, err = parseRateMbps(v); return }},
+	{"LAST RX rate", func(r *DiagnosticReport, v string) (err error) { r.LastRXRateMbps, err = parseRateMbps(v); return }},
+	{"HIGHEST RX rate", func(r *DiagnosticReport, v string) (err error) { r.HighestRXRateMbps, err = parseRateMbps(v); return }},
+	{"BIT RATE", func(r *DiagnosticReport, v string) (err error) { r.BitRate, err = strconv.ParseInt(v, 10, 64); return }},
+	{"WORD LEN", func(r *DiagnosticReport, v string) (err error) { r.WordLen, err = strconv.Atoi(v); return }},
+	{"WORD/FRAME", func(r *DiagnosticReport, v string) (err error) { r.WordsPerFrame, err = strconv.Atoi(v); return }},
+	{"BITS/FRAME", func(r *DiagnosticReport, v string) (err error) { r.BitsPerFrame, err = strconv.Atoi(v); return }},
+	{"MODULATION TYPE", func(r *DiagnosticReport, v string) error { r.ModulationType = v; return nil }},
+	{"TX Digital Los", func(r *DiagnosticReport, v string) (err error) { r.TXDigitalLOS, err = strconv.ParseFloat(v, 64); return }},
+	{"RX Digital Los", func(r *DiagnosticReport, v string) (err error) { r.RXDigitalLOS, err = strconv.ParseFloat(v, 64); return }},
+	{"BEP Test", func(r *DiagnosticReport, v string) (err error) { r.BEPTest, err = strconv.Atoi(v); return }},
+	{"Local Rtrn Count", func(r *DiagnosticReport, v string) (err error) { r.LocalRtrnCount, err = strconv.Atoi(v); return }},
+	{"Remote Rtrn Count", func(r *DiagnosticReport, v string) (err error) { r.RemoteRtrnCount, err = strconv.Atoi(v); return }},
+}
+
+// parseRateMbps parses a "100 MBPS"-style value into its numeric MBPS value.
+func parseRateMbps(value string) (float64, error) {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("telemetry: empty rate value")
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// ParseDiagnosticReport tokenizes the dotted-key-value diagnostic block
+// emitted by the device (one "Label.......... value" pair per line) into a
+// DiagnosticReport. It returns an error naming the offending line if a
+// required field is missing or malformed.
+func ParseDiagnosticReport(raw string) (*DiagnosticReport, error) {
+	lines := strings.Split(strings.TrimRight(raw, "\n"), "\n")
+	if len(lines) != len(diagnosticFields) {
+		return nil, fmt.Errorf("telemetry: expected %d diagnostic lines, got %d", len(diagnosticFields), len(lines))
+	}
+
+	report := &DiagnosticReport{}
+	for i, line := range lines {
+		field := diagnosticFields[i]
+		if !strings.HasPrefix(line, field.label) {
+			return nil, fmt.Errorf("telemetry: line %d: expected label %q, got %q", i+1, field.label, line)
+		}
+		rest := strings.TrimPrefix(line, field.label)
+		rest = strings.TrimLeft(rest, ".")
+		value := strings.TrimSpace(rest)
+		if value == "" {
+			return nil, fmt.Errorf("telemetry: line %d: missing value for %q", i+1, field.label)
+		}
+		if err := field.set(report, value); err != nil {
+			return nil, fmt.Errorf("telemetry: line %d: parsing %q: %w", i+1, field.label, err)
+		}
+	}
+	return report, nil
+}
+
+// Prometheus renders r as Prometheus text-exposition-format gauges, one per
+// numeric field (ModulationType, being non-numeric, is exposed as a label on
+// a constant info metric instead).
+func (r *DiagnosticReport) Prometheus() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "telemetry_last_tx_rate_mbps %v\n", r.LastTXRateMbps)
+	fmt.Fprintf(&b, "telemetry_highest_tx_rate_mbps %v\n", r.HighestTXRateMbps)
+	fmt.Fprintf(&b, "telemetry_last_rx_rate_mbps %v\n", r.LastRXRateMbps)
+	fmt.Fprintf(&b, "telemetry_highest_rx_rate_mbps %v\n", r.HighestRXRateMbps)
+	fmt.Fprintf(&b, "telemetry_bit_rate %d\n", r.BitRate)
+	fmt.Fprintf(&b, "telemetry_word_len %d\n", r.WordLen)
+	fmt.Fprintf(&b, "telemetry_words_per_frame %d\n", r.WordsPerFrame)
+	fmt.Fprintf(&b, "telemetry_bits_per_frame %d\n", r.BitsPerFrame)
+	fmt.Fprintf(&b, "telemetry_tx_digital_los %v\n", r.TXDigitalLOS)
+	fmt.Fprintf(&b, "telemetry_rx_digital_los %v\n", r.RXDigitalLOS)
+	fmt.Fprintf(&b, "telemetry_bep_test %d\n", r.BEPTest)
+	fmt.Fprintf(&b, "telemetry_local_rtrn_count %d\n", r.LocalRtrnCount)
+	fmt.Fprintf(&b, "telemetry_remote_rtrn_count %d\n", r.RemoteRtrnCount)
+	fmt.Fprintf(&b, "telemetry_modulation_type_info{modulation_type=%q} 1\n", r.ModulationType)
+	return b.String()
+}
+
+// Format selects how TelemetryDiagnostics renders DiagnosticInfo after a
+// successful CheckTransmission.
+type Format int
+
+const (
+	// FormatText leaves DiagnosticInfo as the raw text block received from
+	// the device (the historical behavior).
+	FormatText Format = iota
+	// FormatJSON parses the raw block into a DiagnosticReport and stores its
+	// JSON encoding in DiagnosticInfo.
+	FormatJSON
+	// FormatProm parses the raw block and stores its Prometheus text
+	// exposition format in DiagnosticInfo.
+	FormatProm
+)
+
+// formatDiagnosticInfo renders raw according to format, parsing it into a
+// DiagnosticReport first when structured output was requested.
+func formatDiagnosticInfo(raw string, format Format) (string, error) {
+	switch format {
+	case FormatText:
+		return raw, nil
+	case FormatJSON:
+		report, err := ParseDiagnosticReport(raw)
+		if err != nil {
+			return "", err
+		}
+		b, err := json.Marshal(report)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case FormatProm:
+		report, err := ParseDiagnosticReport(raw)
+		if err != nil {
+			return "", err
+		}
+		return report.Prometheus(), nil
+	default:
+		return "", fmt.Errorf("telemetry: unknown Format %d", format)
+	}
+}
@@ -0,0 +1,166 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"refactor/telemetry/mocks"
+)
+
+const sampleDiagnosticBlock = `LAST TX rate................ 100 MBPS
+HIGHEST TX rate............. 100 MBPS
+LAST RX rate................ 100 MBPS
+HIGHEST RX rate............. 100 MBPS
+BIT RATE.................... 100000000
+WORD LEN.................... 16
+WORD/FRAME.................. 511
+BITS/FRAME.................. 8192
+MODULATION TYPE............. PCM/FM
+TX Digital Los.............. 0.75
+RX Digital Los.............. 0.10
+BEP Test.................... -5
+Local Rtrn Count............ 00
+Remote Rtrn Count........... 00`
+
+func TestParseDiagnosticReport(t *testing.T) {
+	report, err := ParseDiagnosticReport(sampleDiagnosticBlock)
+	if err != nil {
+		t.Fatalf("ParseDiagnosticReport failed: %v", err)
+	}
+
+	want := &DiagnosticReport{
+		LastTXRateMbps:    100,
+		HighestTXRateMbps: 100,
+		LastRXRateMbps:    100,
+		HighestRXRateMbps: 100,
+		BitRate:           100000000,
+		WordLen:           16,
+		WordsPerFrame:     511,
+		BitsPerFrame:      8192,
+		ModulationType:    "PCM/FM",
+		TXDigitalLOS:      0.75,
+		RXDigitalLOS:      0.10,
+		BEPTest:           -5,
+		LocalRtrnCount:    0,
+		RemoteRtrnCount:   0,
+	}
+
+	if *report != *want {
+		t.Errorf("parsed report mismatch.\nGot:  %+v\nWant: %+v", report, want)
+	}
+}
+
+func TestParseDiagnosticReport_WrongLineCount(t *testing.T) {
+	_, err := ParseDiagnosticReport("LAST TX rate................ 100 MBPS")
+	if err == nil {
+		t.Fatal("expected an error for a truncated diagnostic block, got nil")
+	}
+}
+
+func TestParseDiagnosticReport_MalformedValue(t *testing.T) {
+	bad := strings.Replace(sampleDiagnosticBlock, "BIT RATE.................... 100000000", "BIT RATE.................... not-a-number", 1)
+	_, err := ParseDiagnosticReport(bad)
+	if err == nil {
+		t.Fatal("expected an error for a non-numeric BIT RATE value, got nil")
+	}
+}
+
+// TestDiagnosticReport_JSONRoundTrip verifies a DiagnosticReport survives a
+// JSON marshal/unmarshal cycle unchanged.
+func TestDiagnosticReport_JSONRoundTrip(t *testing.T) {
+	report, err := ParseDiagnosticReport(sampleDiagnosticBlock)
+	if err != nil {
+		t.Fatalf("ParseDiagnosticReport failed: %v", err)
+	}
+
+	b, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+
+	var roundTripped DiagnosticReport
+	if err := json.Unmarshal(b, &roundTripped); err != nil {
+		t.Fatalf("json.Unmarshal failed: %v", err)
+	}
+
+	if roundTripped != *report {
+		t.Errorf("round trip mismatch.\nGot:  %+v\nWant: %+v", roundTripped, *report)
+	}
+}
+
+// TestCheckTransmission_FormatJSON verifies Format: FormatJSON renders
+// DiagnosticInfo as the JSON encoding of the parsed report.
+func TestCheckTransmission_FormatJSON(t *testing.T) {
+	client := mocks.NewClient(t)
+	online := false
+	onlineStatusStub(client, &online)
+
+	mock.InOrder(
+		client.On("Disconnect", mock.Anything).Return(nil).Once(),
+		client.On("Connect", mock.Anything, DiagnosticChannelConnectionString).
+			Run(func(mock.Arguments) { online = true }).Return(nil).Once(),
+		client.On("Send", mock.Anything, DiagnosticMessage).Return(nil).Once(),
+		client.On("Receive", mock.Anything).Return(sampleDiagnosticBlock, nil).Once(),
+	)
+	diagnostics := NewTelemetryDiagnostics(client, DiagnosticsOptions{Format: FormatJSON})
+
+	if err := diagnostics.CheckTransmission(context.Background()); err != nil {
+		t.Fatalf("CheckTransmission failed: %v", err)
+	}
+
+	var report DiagnosticReport
+	if err := json.Unmarshal([]byte(diagnostics.DiagnosticInfo), &report); err != nil {
+		t.Fatalf("DiagnosticInfo is not valid JSON: %v\n%s", err, diagnostics.DiagnosticInfo)
+	}
+	if report.ModulationType != "PCM/FM" {
+		t.Errorf("ModulationType: got %q, want %q", report.ModulationType, "PCM/FM")
+	}
+}
+
+// TestCheckTransmission_FormatProm verifies Format: FormatProm renders
+// DiagnosticInfo as Prometheus text exposition format.
+func TestCheckTransmission_FormatProm(t *testing.T) {
+	client := mocks.NewClient(t)
+	online := false
+	onlineStatusStub(client, &online)
+
+	mock.InOrder(
+		client.On("Disconnect", mock.Anything).Return(nil).Once(),
+		client.On("Connect", mock.Anything, DiagnosticChannelConnectionString).
+			Run(func(mock.Arguments) { online = true }).Return(nil).Once(),
+		client.On("Send", mock.Anything, DiagnosticMessage).Return(nil).Once(),
+		client.On("Receive", mock.Anything).Return(sampleDiagnosticBlock, nil).Once(),
+	)
+	diagnostics := NewTelemetryDiagnostics(client, DiagnosticsOptions{Format: FormatProm})
+
+	if err := diagnostics.CheckTransmission(context.Background()); err != nil {
+		t.Fatalf("CheckTransmission failed: %v", err)
+	}
+
+	if !strings.Contains(diagnostics.DiagnosticInfo, "telemetry_bit_rate 100000000") {
+		t.Errorf("DiagnosticInfo missing expected Prometheus line:\n%s", diagnostics.DiagnosticInfo)
+	}
+}
+
+// BenchmarkParseDiagnosticReport_Corpus measures parsing throughput over a
+// ~10k-line corpus of diagnostic blocks.
+func BenchmarkParseDiagnosticReport_Corpus(b *testing.B) {
+	const blocksInCorpus = 10000 / 13
+	corpus := make([]string, blocksInCorpus)
+	for i := range corpus {
+		corpus[i] = sampleDiagnosticBlock
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, block := range corpus {
+			if _, err := ParseDiagnosticReport(block); err != nil {
+				b.Fatalf("ParseDiagnosticReport failed: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package telemetrygrpc implements telemetry.Client over a real gRPC
+// connection to a TelemetryService endpoint, as an alternative to the
+// in-memory telemetry.TelemetryClient used for local testing.
+package telemetrygrpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"refactor/telemetry"
+)
+
+// GRPCClient implements telemetry.Client by dispatching to a
+// TelemetryService over a gRPC connection.
+type GRPCClient struct {
+	conn *grpc.ClientConn
+	stub TelemetryServiceClient
+
+	mu     sync.Mutex
+	stream TelemetryService_ConnectClient
+	online bool
+}
+
+var _ telemetry.Client = (*GRPCClient)(nil)
+
+// NewGRPCClient dials target and returns a GRPCClient backed by the
+// resulting connection. opts are forwarded to grpc.NewClient, so callers
+// supply transport credentials (see WithServerTLS), keepalive parameters,
+// and interceptors the same way they would for any other gRPC client.
+func NewGRPCClient(target string, opts ...grpc.DialOption) (*GRPCClient, error) {
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry/grpc: dialing %s: %w", target, err)
+	}
+	return &GRPCClient{
+		conn: conn,
+		stub: NewTelemetryServiceClient(conn),
+	}, nil
+}
+
+// WithServerTLS returns a grpc.DialOption that authenticates the server
+// using the PEM certificate at certFile. serverNameOverride may be left
+// empty to use the hostname from target.
+func WithServerTLS(certFile, serverNameOverride string) (grpc.DialOption, error) {
+	creds, err := credentials.NewClientTLSFromFile(certFile, serverNameOverride)
+	if err != nil {
+		return nil, fmt.Errorf("telemetry/grpc: loading TLS credentials: %w", err)
+	}
+	return grpc.WithTransportCredentials(creds), nil
+}
+
+// Close releases the underlying gRPC connection. It is not part of the
+// telemetry.Client interface since TelemetryClient has no equivalent
+// teardown, but callers that constructed a GRPCClient own its connection
+// and should call Close when they are done with it.
+func (c *GRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+// Connect opens the keepalive stream and blocks until the server reports
+// the initial online status.
+func (c *GRPCClient) Connect(ctx context.Context, connectionString string) error {
+	if connectionString == "" {
+		return telemetry.ErrEmptyConnectionString
+	}
+
+	stream, err := c.stub.Connect(ctx)
+	if err != nil {
+		return fmt.Errorf("telemetry/grpc: opening keepalive stream: %w", err)
+	}
+	if err := stream.Send(&KeepaliveRequest{ConnectionString: connectionString}); err != nil {
+		return fmt.Errorf("telemetry/grpc: sending connect request: %w", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("telemetry/grpc: receiving connect response: %w", err)
+	}
+
+	c.mu.Lock()
+	c.stream = stream
+	c.online = resp.GetOnline()
+	c.mu.Unlock()
+
+	go c.watchKeepalive(stream)
+	return nil
+}
+
+// watchKeepalive keeps onlineStatus in sync with the server's keepalive
+// stream until it ends, at which point the client is marked offline.
+func (c *GRPCClient) watchKeepalive(stream TelemetryService_ConnectClient) {
+	for {
+		resp, err := stream.Recv()
+		c.mu.Lock()
+		if c.stream != stream {
+			c.mu.Unlock()
+			return
+		}
+		if err != nil {
+			c.stream = nil
+			c.online = false
+			c.mu.Unlock()
+			return
+		}
+		c.online = resp.GetOnline()
+		c.mu.Unlock()
+	}
+}
+
+// Disconnect tears down the keepalive stream and notifies the server the
+// session is over.
+func (c *GRPCClient) Disconnect(ctx context.Context) error {
+	c.mu.Lock()
+	stream := c.stream
+	c.stream = nil
+	c.online = false
+	c.mu.Unlock()
+
+	if stream != nil {
+		_ = stream.CloseSend()
+	}
+	if _, err := c.stub.Disconnect(ctx, &DisconnectRequest{}); err != nil {
+		return fmt.Errorf("telemetry/grpc: disconnect: %w", err)
+	}
+	return nil
+}
+
+// Send transmits message to the telemetry server.
+func (c *GRPCClient) Send(ctx context.Context, message string) error {
+	if message == "" {
+		return telemetry.ErrEmptyMessage
+	}
+	if !c.OnlineStatus() {
+		return telemetry.ErrOffline
+	}
+	if _, err := c.stub.Send(ctx, &Message{Body: message}); err != nil {
+		return fmt.Errorf("telemetry/grpc: send: %w", err)
+	}
+	return nil
+}
+
+// Receive retrieves the next diagnostic report from the telemetry server
+// and renders it back into the dotted-key-value text block that
+// telemetry.ParseDiagnosticReport expects, so TelemetryDiagnostics works the
+// same way against either transport.
+func (c *GRPCClient) Receive(ctx context.Context) (string, error) {
+	if !c.OnlineStatus() {
+		return "", telemetry.ErrOffline
+	}
+	report, err := c.stub.Receive(ctx, &ReceiveRequest{})
+	if err != nil {
+		return "", fmt.Errorf("telemetry/grpc: receive: %w", err)
+	}
+	return renderDiagnosticReport(report), nil
+}
+
+// OnlineStatus reports whether the most recent keepalive message from the
+// server indicated the client is online.
+func (c *GRPCClient) OnlineStatus() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.online
+}
+
+// renderDiagnosticReport formats report as the dotted-key-value text block
+// TelemetryClient.Receive produces, field order included.
+func renderDiagnosticReport(r *DiagnosticReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "LAST TX rate................ %v MBPS\n", r.GetLastTxRateMbps())
+	fmt.Fprintf(&b, "HIGHEST TX rate............. %v MBPS\n", r.GetHighestTxRateMbps())
+	fmt.Fprintf(&b, "LAST RX rate................ %v MBPS\n", r.GetLastRxRateMbps())
+	fmt.Fprintf(&b, "HIGHEST RX rate............. %v MBPS\n", r.GetHighestRxRateMbps())
+	fmt.Fprintf(&b, "BIT RATE.................... %d\n", r.GetBitRate())
+	fmt.Fprintf(&b, "WORD LEN.................... %d\n", r.GetWordLen())
+	fmt.Fprintf(&b, "WORD/FRAME.................. %d\n", r.GetWordsPerFrame())
+	fmt.Fprintf(&b, "BITS/FRAME.................. %d\n", r.GetBitsPerFrame())
+	fmt.Fprintf(&b, "MODULATION TYPE............. %s\n", r.GetModulationType())
+	fmt.Fprintf(&b, "TX Digital Los.............. %v\n", r.GetTxDigitalLos())
+	fmt.Fprintf(&b, "RX Digital Los.............. %v\n", r.GetRxDigitalLos())
+	fmt.Fprintf(&b, "BEP Test.................... %d\n", r.GetBepTest())
+	fmt.Fprintf(&b, "Local Rtrn Count............ %02d\n", r.GetLocalRtrnCount())
+	fmt.Fprintf(&b, "Remote Rtrn Count........... %02d", r.GetRemoteRtrnCount())
+	return b.String()
+}
@@ -0,0 +1,175 @@
+package telemetrygrpc
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"refactor/telemetry"
+)
+
+// fakeTelemetryServer is a minimal in-process TelemetryServiceServer used to
+// exercise GRPCClient without a real diagnostic endpoint.
+type fakeTelemetryServer struct {
+	UnimplementedTelemetryServiceServer
+
+	online           bool
+	lastSentMessage  string
+	disconnectCalled bool
+	report           *DiagnosticReport
+}
+
+func (s *fakeTelemetryServer) Connect(stream TelemetryService_ConnectServer) error {
+	if _, err := stream.Recv(); err != nil {
+		return err
+	}
+	if err := stream.Send(&KeepaliveResponse{Online: s.online}); err != nil {
+		return err
+	}
+	// Keep the keepalive stream open until the client closes it, rather than
+	// returning right after the first response, so GRPCClient.watchKeepalive
+	// doesn't see an immediate EOF and mark the client offline mid-test.
+	_, err := stream.Recv()
+	return err
+}
+
+func (s *fakeTelemetryServer) Disconnect(ctx context.Context, req *DisconnectRequest) (*DisconnectResponse, error) {
+	s.disconnectCalled = true
+	return &DisconnectResponse{}, nil
+}
+
+func (s *fakeTelemetryServer) Send(ctx context.Context, msg *Message) (*SendResponse, error) {
+	s.lastSentMessage = msg.GetBody()
+	return &SendResponse{}, nil
+}
+
+func (s *fakeTelemetryServer) Receive(ctx context.Context, req *ReceiveRequest) (*DiagnosticReport, error) {
+	return s.report, nil
+}
+
+// newTestClient starts fake on an in-process bufconn listener and returns a
+// GRPCClient dialed against it, along with a cleanup func.
+func newTestClient(t *testing.T, fake *fakeTelemetryServer) (*GRPCClient, func()) {
+	t.Helper()
+
+	const bufSize = 1 << 20
+	lis := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	RegisterTelemetryServiceServer(server, fake)
+	go server.Serve(lis)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+	client, err := NewGRPCClient("passthrough:///bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("NewGRPCClient: %v", err)
+	}
+
+	return client, func() {
+		client.Close()
+		server.Stop()
+		lis.Close()
+	}
+}
+
+func TestGRPCClient_Connect(t *testing.T) {
+	t.Run("EmptyConnectionString", func(t *testing.T) {
+		client, cleanup := newTestClient(t, &fakeTelemetryServer{online: true})
+		defer cleanup()
+
+		if err := client.Connect(context.Background(), ""); err != telemetry.ErrEmptyConnectionString {
+			t.Errorf("Connect(\"\"): got %v, want %v", err, telemetry.ErrEmptyConnectionString)
+		}
+	})
+
+	t.Run("ReflectsServerOnlineStatus", func(t *testing.T) {
+		client, cleanup := newTestClient(t, &fakeTelemetryServer{online: true})
+		defer cleanup()
+
+		if err := client.Connect(context.Background(), "*111#"); err != nil {
+			t.Fatalf("Connect: %v", err)
+		}
+		if !client.OnlineStatus() {
+			t.Errorf("OnlineStatus: got false, want true")
+		}
+	})
+}
+
+func TestGRPCClient_SendReceive(t *testing.T) {
+	fake := &fakeTelemetryServer{
+		online: true,
+		report: &DiagnosticReport{
+			LastTxRateMbps: 100,
+			BitRate:        100000000,
+			ModulationType: "PCM/FM",
+		},
+	}
+	client, cleanup := newTestClient(t, fake)
+	defer cleanup()
+
+	if err := client.Connect(context.Background(), "*111#"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+
+	if err := client.Send(context.Background(), telemetry.DiagnosticMessage); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if fake.lastSentMessage != telemetry.DiagnosticMessage {
+		t.Errorf("server received %q, want %q", fake.lastSentMessage, telemetry.DiagnosticMessage)
+	}
+
+	raw, err := client.Receive(context.Background())
+	if err != nil {
+		t.Fatalf("Receive: %v", err)
+	}
+	if !strings.Contains(raw, "PCM/FM") {
+		t.Errorf("Receive: got %q, want it to contain the modulation type", raw)
+	}
+
+	if _, err := telemetry.ParseDiagnosticReport(raw); err != nil {
+		t.Errorf("ParseDiagnosticReport(Receive() output): %v", err)
+	}
+}
+
+func TestGRPCClient_SendWhenOffline(t *testing.T) {
+	client, cleanup := newTestClient(t, &fakeTelemetryServer{online: false})
+	defer cleanup()
+
+	if err := client.Connect(context.Background(), "*111#"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if err := client.Send(context.Background(), "hello"); err != telemetry.ErrOffline {
+		t.Errorf("Send while offline: got %v, want %v", err, telemetry.ErrOffline)
+	}
+	if _, err := client.Receive(context.Background()); err != telemetry.ErrOffline {
+		t.Errorf("Receive while offline: got %v, want %v", err, telemetry.ErrOffline)
+	}
+}
+
+func TestGRPCClient_Disconnect(t *testing.T) {
+	fake := &fakeTelemetryServer{online: true}
+	client, cleanup := newTestClient(t, fake)
+	defer cleanup()
+
+	if err := client.Connect(context.Background(), "*111#"); err != nil {
+		t.Fatalf("Connect: %v", err)
+	}
+	if err := client.Disconnect(context.Background()); err != nil {
+		t.Fatalf("Disconnect: %v", err)
+	}
+	if !fake.disconnectCalled {
+		t.Errorf("Disconnect: server was not notified")
+	}
+	if client.OnlineStatus() {
+		t.Errorf("OnlineStatus after Disconnect: got true, want false")
+	}
+}
@@ -0,0 +1,103 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Logger receives structured events from TelemetryClient and
+// TelemetryDiagnostics: connect attempts, retry decisions, message
+// send/receive sizes, and diagnostic outcomes. kv is an alternating
+// key/value list (e.g. "component", "client", "attempt", 2), following the
+// same convention as log/slog. Implementations must be safe for concurrent
+// use.
+type Logger interface {
+	Debug(msg string, kv ...any)
+	Info(msg string, kv ...any)
+	Warn(msg string, kv ...any)
+	Error(msg string, kv ...any)
+}
+
+// NopLogger discards every event. It is the Logger TelemetryClient and
+// TelemetryDiagnostics use when none is configured, and is useful in tests
+// that don't care about logging output.
+type NopLogger struct{}
+
+func (NopLogger) Debug(string, ...any) {}
+func (NopLogger) Info(string, ...any)  {}
+func (NopLogger) Warn(string, ...any)  {}
+func (NopLogger) Error(string, ...any) {}
+
+// TextLogger writes human-readable lines of the form "LEVEL msg key=value
+// key=value" to Out.
+type TextLogger struct {
+	Out io.Writer
+}
+
+// NewTextLogger returns a TextLogger that writes to w.
+func NewTextLogger(w io.Writer) *TextLogger {
+	return &TextLogger{Out: w}
+}
+
+func (l *TextLogger) log(level, msg string, kv []any) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteByte(' ')
+	b.WriteString(msg)
+	for i := 0; i+1 < len(kv); i += 2 {
+		fmt.Fprintf(&b, " %v=%v", kv[i], kv[i+1])
+	}
+	b.WriteByte('\n')
+	io.WriteString(l.Out, b.String())
+}
+
+func (l *TextLogger) Debug(msg string, kv ...any) { l.log("DEBUG", msg, kv) }
+func (l *TextLogger) Info(msg string, kv ...any)  { l.log("INFO", msg, kv) }
+func (l *TextLogger) Warn(msg string, kv ...any)  { l.log("WARN", msg, kv) }
+func (l *TextLogger) Error(msg string, kv ...any) { l.log("ERROR", msg, kv) }
+
+// JSONLogger writes one JSON object per line to Out, with "level", "event",
+// and "time" fields plus whatever key/value pairs the call supplies (e.g.
+// component, attempt, elapsed_ms, err).
+type JSONLogger struct {
+	Out io.Writer
+}
+
+// NewJSONLogger returns a JSONLogger that writes to w.
+func NewJSONLogger(w io.Writer) *JSONLogger {
+	return &JSONLogger{Out: w}
+}
+
+func (l *JSONLogger) log(level, msg string, kv []any) {
+	entry := map[string]any{
+		"level": level,
+		"event": msg,
+		"time":  time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	for i := 0; i+1 < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			key = fmt.Sprint(kv[i])
+		}
+		value := kv[i+1]
+		if err, ok := value.(error); ok {
+			value = err.Error()
+		}
+		entry[key] = value
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		fmt.Fprintf(l.Out, "{\"level\":\"error\",\"event\":\"telemetry: failed to marshal log entry\",\"err\":%q}\n", err)
+		return
+	}
+	l.Out.Write(append(b, '\n'))
+}
+
+func (l *JSONLogger) Debug(msg string, kv ...any) { l.log("debug", msg, kv) }
+func (l *JSONLogger) Info(msg string, kv ...any)  { l.log("info", msg, kv) }
+func (l *JSONLogger) Warn(msg string, kv ...any)  { l.log("warn", msg, kv) }
+func (l *JSONLogger) Error(msg string, kv ...any) { l.log("error", msg, kv) }
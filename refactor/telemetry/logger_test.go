@@ -0,0 +1,121 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+
+	"refactor/telemetry/mocks"
+)
+
+// decodeJSONLines parses each non-empty line of b as a JSON object.
+func decodeJSONLines(t *testing.T, b []byte) []map[string]any {
+	t.Helper()
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimRight(string(b), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("line is not valid JSON: %v\n%s", err, line)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestJSONLogger_EmitsStructuredFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewJSONLogger(&buf)
+
+	logger.Info("connected", "component", "client", "attempt", 2)
+	logger.Error("send failed", "component", "client", "err", ErrOffline)
+
+	entries := decodeJSONLines(t, buf.Bytes())
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 log lines, got %d", len(entries))
+	}
+
+	connected := entries[0]
+	if connected["level"] != "info" {
+		t.Errorf("level: got %v, want %q", connected["level"], "info")
+	}
+	if connected["event"] != "connected" {
+		t.Errorf("event: got %v, want %q", connected["event"], "connected")
+	}
+	if connected["component"] != "client" {
+		t.Errorf("component: got %v, want %q", connected["component"], "client")
+	}
+	if connected["attempt"] != float64(2) {
+		t.Errorf("attempt: got %v, want %v", connected["attempt"], 2)
+	}
+	if _, ok := connected["time"]; !ok {
+		t.Errorf("expected a time field, got none: %v", connected)
+	}
+
+	failed := entries[1]
+	if failed["level"] != "error" {
+		t.Errorf("level: got %v, want %q", failed["level"], "error")
+	}
+	if failed["err"] != ErrOffline.Error() {
+		t.Errorf("err: got %v, want %q", failed["err"], ErrOffline.Error())
+	}
+}
+
+func TestTelemetryClient_LogsConnectAndSend(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewTelemetryClient(WithLogger(NewJSONLogger(&buf)))
+
+	if err := client.Send(context.Background(), "hello"); err == nil {
+		t.Fatal("expected Send to fail while offline")
+	}
+
+	entries := decodeJSONLines(t, buf.Bytes())
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log line, got %d", len(entries))
+	}
+	if entries[0]["event"] != "send failed" {
+		t.Errorf("event: got %v, want %q", entries[0]["event"], "send failed")
+	}
+	if entries[0]["err"] != ErrOffline.Error() {
+		t.Errorf("err: got %v, want %q", entries[0]["err"], ErrOffline.Error())
+	}
+}
+
+func TestTelemetryDiagnostics_LogsCheckTransmissionOutcome(t *testing.T) {
+	client := mocks.NewClient(t)
+	online := false
+	onlineStatusStub(client, &online)
+
+	mock.InOrder(
+		client.On("Disconnect", mock.Anything).Return(nil).Once(),
+		client.On("Connect", mock.Anything, DiagnosticChannelConnectionString).
+			Run(func(mock.Arguments) { online = true }).Return(nil).Once(),
+		client.On("Send", mock.Anything, DiagnosticMessage).Return(nil).Once(),
+		client.On("Receive", mock.Anything).Return(sampleDiagnosticBlock, nil).Once(),
+	)
+
+	var buf bytes.Buffer
+	diagnostics := NewTelemetryDiagnostics(client, DiagnosticsOptions{Logger: NewJSONLogger(&buf)})
+
+	if err := diagnostics.CheckTransmission(context.Background()); err != nil {
+		t.Fatalf("CheckTransmission failed: %v", err)
+	}
+
+	entries := decodeJSONLines(t, buf.Bytes())
+	last := entries[len(entries)-1]
+	if last["event"] != "check transmission succeeded" {
+		t.Errorf("event: got %v, want %q", last["event"], "check transmission succeeded")
+	}
+	if last["attempts"] != float64(1) {
+		t.Errorf("attempts: got %v, want %v", last["attempts"], 1)
+	}
+	if _, ok := last["elapsed_ms"]; !ok {
+		t.Errorf("expected an elapsed_ms field, got none: %v", last)
+	}
+}
@@ -1,7 +1,10 @@
 package telemetry
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
@@ -11,12 +14,33 @@ const (
 	DiagnosticMessage = "AT#UD"
 )
 
-// Client defines the interface for a telemetry client.
+// Sentinel errors returned by Client implementations instead of panicking.
+// Callers should match them with errors.Is.
+var (
+	// ErrEmptyConnectionString is returned by Connect when given an empty
+	// connection string.
+	ErrEmptyConnectionString = errors.New("telemetry: connection string is empty")
+	// ErrEmptyMessage is returned by Send when given an empty message.
+	ErrEmptyMessage = errors.New("telemetry: message is empty")
+	// ErrOffline is returned by Send/Receive when the client is offline.
+	ErrOffline = errors.New("telemetry: not connected")
+	// ErrCanceled is returned when an operation is abandoned because its
+	// context was canceled or its deadline exceeded.
+	ErrCanceled = errors.New("telemetry: operation canceled")
+	// ErrUnableToConnect is returned by CheckTransmission when every retry
+	// attempt failed to bring the client online.
+	ErrUnableToConnect = errors.New("telemetry: unable to connect")
+)
+
+// Client defines the interface for a telemetry client. All methods accept a
+// context.Context and return an error instead of panicking; callers that
+// cancel ctx should expect ErrCanceled (or the context's own error) rather
+// than a hang.
 type Client interface {
-	Connect(connectionString string)
-	Disconnect()
-	Send(message string)
-	Receive() string
+	Connect(ctx context.Context, connectionString string) error
+	Disconnect(ctx context.Context) error
+	Send(ctx context.Context, message string) error
+	Receive(ctx context.Context) (string, error)
 	OnlineStatus() bool
 }
 
@@ -25,13 +49,28 @@ type TelemetryClient struct {
 	onlineStatus              bool
 	diagnosticMessageJustSent bool
 	rand                      *rand.Rand
+	logger                    Logger
+}
+
+// Option configures a TelemetryClient constructed by NewTelemetryClient.
+type Option func(*TelemetryClient)
+
+// WithLogger sets the Logger TelemetryClient uses to record connect
+// attempts and message send/receive activity. Defaults to NopLogger.
+func WithLogger(logger Logger) Option {
+	return func(c *TelemetryClient) { c.logger = logger }
 }
 
 // NewTelemetryClient creates a new TelemetryClient.
-func NewTelemetryClient() *TelemetryClient {
-	return &TelemetryClient{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+func NewTelemetryClient(opts ...Option) *TelemetryClient {
+	c := &TelemetryClient{
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+		logger: NopLogger{},
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 // OnlineStatus returns the online status of the client.
@@ -39,37 +78,75 @@ func (c *TelemetryClient) OnlineStatus() bool {
 	return c.onlineStatus
 }
 
+// log returns c.logger, falling back to NopLogger for a TelemetryClient
+// constructed as a struct literal rather than via NewTelemetryClient.
+func (c *TelemetryClient) log() Logger {
+	if c.logger == nil {
+		return NopLogger{}
+	}
+	return c.logger
+}
+
 // Connect establishes a connection to the telemetry server.
-func (c *TelemetryClient) Connect(telemetryServerConnectionString string) {
+func (c *TelemetryClient) Connect(ctx context.Context, telemetryServerConnectionString string) error {
+	if err := ctxError(ctx); err != nil {
+		return err
+	}
 	if telemetryServerConnectionString == "" {
-		panic("telemetryServerConnectionString is null or empty")
+		c.log().Error("connect failed", "component", "client", "err", ErrEmptyConnectionString)
+		return ErrEmptyConnectionString
 	}
 
 	// Fake the connection with 20% chances of success
 	success := c.rand.Intn(10) < 2
 	c.onlineStatus = success
+	if success {
+		c.log().Info("connected", "component", "client")
+	} else {
+		c.log().Warn("connect attempt failed", "component", "client")
+	}
+	return nil
 }
 
 // Disconnect closes the connection to the telemetry server.
-func (c *TelemetryClient) Disconnect() {
+func (c *TelemetryClient) Disconnect(ctx context.Context) error {
+	if err := ctxError(ctx); err != nil {
+		return err
+	}
 	c.onlineStatus = false
+	c.log().Info("disconnected", "component", "client")
+	return nil
 }
 
 // Send sends a message to the telemetry server.
-func (c *TelemetryClient) Send(message string) {
+func (c *TelemetryClient) Send(ctx context.Context, message string) error {
+	if err := ctxError(ctx); err != nil {
+		return err
+	}
 	if message == "" {
-		panic("message is null or empty")
+		c.log().Error("send failed", "component", "client", "err", ErrEmptyMessage)
+		return ErrEmptyMessage
 	}
-
-	if message == DiagnosticMessage {
-		c.diagnosticMessageJustSent = true
-	} else {
-		c.diagnosticMessageJustSent = false
+	if !c.onlineStatus {
+		c.log().Warn("send failed", "component", "client", "err", ErrOffline)
+		return ErrOffline
 	}
+
+	c.diagnosticMessageJustSent = message == DiagnosticMessage
+	c.log().Debug("sent message", "component", "client", "bytes", len(message))
+	return nil
 }
 
 // Receive receives a message from the telemetry server.
-func (c *TelemetryClient) Receive() string {
+func (c *TelemetryClient) Receive(ctx context.Context) (string, error) {
+	if err := ctxError(ctx); err != nil {
+		return "", err
+	}
+	if !c.onlineStatus {
+		c.log().Warn("receive failed", "component", "client", "err", ErrOffline)
+		return "", ErrOffline
+	}
+
 	if c.diagnosticMessageJustSent {
 		// Simulate the reception of the diagnostic message
 		message := `LAST TX rate................ 100 MBPS
@@ -87,7 +164,8 @@ BEP Test.................... -5
 Local Rtrn Count............ 00
 Remote Rtrn Count........... 00`
 		c.diagnosticMessageJustSent = false
-		return message
+		c.log().Debug("received message", "component", "client", "bytes", len(message))
+		return message, nil
 	}
 
 	// Simulate the reception of a response message returning a random message.
@@ -96,40 +174,174 @@ Remote Rtrn Count........... 00`
 	for i := 0; i < messageLength; i++ {
 		messageBuilder.WriteByte(byte(c.rand.Intn(40) + 86))
 	}
-	return messageBuilder.String()
+	c.log().Debug("received message", "component", "client", "bytes", messageBuilder.Len())
+	return messageBuilder.String(), nil
 }
 
 const DiagnosticChannelConnectionString = "*111#"
 
+// ctxError wraps ctx.Err() (if any) with ErrCanceled, so callers can match
+// either the sentinel or the underlying context.Canceled/DeadlineExceeded.
+func ctxError(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrCanceled, err)
+	}
+	return nil
+}
+
+// retryJitterRand backs RetryPolicy.nextDelay's jitter term.
+var retryJitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+
+// RetryPolicy configures the connect-retry loop in CheckTransmission: up to
+// MaxAttempts attempts, waiting InitialDelay*Multiplier^(attempt-1) (capped
+// at MaxDelay, if positive) between them, plus up to Jitter of additional
+// random delay so many clients backing off at once don't retry in lockstep.
+type RetryPolicy struct {
+	// MaxAttempts is the number of connect attempts CheckTransmission makes
+	// before giving up. Defaults to 3 when zero.
+	MaxAttempts int
+	// InitialDelay is the delay before the second attempt. Zero means no
+	// delay between attempts.
+	InitialDelay time.Duration
+	// Multiplier scales InitialDelay for each subsequent attempt. Values
+	// <= 0 are treated as 1 (a constant delay).
+	Multiplier float64
+	// MaxDelay caps the computed delay, if positive.
+	MaxDelay time.Duration
+	// Jitter adds a random delay in [0, Jitter) on top of the computed
+	// delay. Zero disables jitter.
+	Jitter time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	return p
+}
+
+// nextDelay computes how long CheckTransmission should wait before the given
+// 1-based attempt number.
+func (p RetryPolicy) nextDelay(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	delay := time.Duration(float64(p.InitialDelay) * math.Pow(multiplier, float64(attempt-1)))
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		delay += time.Duration(retryJitterRand.Int63n(int64(p.Jitter)))
+	}
+	return delay
+}
+
+// DiagnosticsOptions configures TelemetryDiagnostics' retry behavior.
+type DiagnosticsOptions struct {
+	// Retry configures the connect-retry loop. Defaults to 3 attempts with
+	// no delay between them.
+	Retry RetryPolicy
+	// Format selects how DiagnosticInfo is rendered once the transmission
+	// check succeeds. Defaults to FormatText (the raw device output).
+	Format Format
+	// Logger records connect attempts, retry decisions, and the outcome of
+	// CheckTransmission. Defaults to NopLogger.
+	Logger Logger
+}
+
+func (o DiagnosticsOptions) withDefaults() DiagnosticsOptions {
+	o.Retry = o.Retry.withDefaults()
+	if o.Logger == nil {
+		o.Logger = NopLogger{}
+	}
+	return o
+}
+
 // TelemetryDiagnostics handles the diagnostics for the telemetry system.
 type TelemetryDiagnostics struct {
 	client         Client
+	options        DiagnosticsOptions
 	DiagnosticInfo string
 }
 
-// NewTelemetryDiagnostics creates a new TelemetryDiagnostics.
-func NewTelemetryDiagnostics(client Client) *TelemetryDiagnostics {
+// NewTelemetryDiagnostics creates a new TelemetryDiagnostics. An optional
+// DiagnosticsOptions may be passed to configure retry attempts and backoff;
+// it defaults to 3 attempts with no delay between them.
+func NewTelemetryDiagnostics(client Client, opts ...DiagnosticsOptions) *TelemetryDiagnostics {
+	o := DiagnosticsOptions{}
+	if len(opts) > 0 {
+		o = opts[0]
+	}
 	return &TelemetryDiagnostics{
-		client: client,
+		client:  client,
+		options: o.withDefaults(),
 	}
 }
 
-// CheckTransmission performs a transmission check.
-func (d *TelemetryDiagnostics) CheckTransmission() error {
+// CheckTransmission performs a transmission check, retrying the connect step
+// according to d's DiagnosticsOptions. If ctx is canceled or its deadline is
+// exceeded while retrying, that context error is returned directly so
+// callers can match it with errors.Is(err, context.Canceled) or
+// errors.Is(err, context.DeadlineExceeded).
+func (d *TelemetryDiagnostics) CheckTransmission(ctx context.Context) error {
+	start := time.Now()
+	logger := d.options.Logger
 	d.DiagnosticInfo = ""
-	d.client.Disconnect()
+	if err := d.client.Disconnect(ctx); err != nil {
+		logger.Error("check transmission failed", "component", "diagnostics", "err", err)
+		return err
+	}
+
+	attempt := 0
+	for !d.client.OnlineStatus() && attempt < d.options.Retry.MaxAttempts {
+		if err := ctxError(ctx); err != nil {
+			logger.Error("check transmission canceled", "component", "diagnostics", "attempt", attempt, "err", err)
+			return err
+		}
 
-	retryLeft := 3
-	for !d.client.OnlineStatus() && retryLeft > 0 {
-		d.client.Connect(DiagnosticChannelConnectionString)
-		retryLeft--
+		if err := d.client.Connect(ctx, DiagnosticChannelConnectionString); err != nil {
+			logger.Error("check transmission failed", "component", "diagnostics", "attempt", attempt+1, "err", err)
+			return err
+		}
+		attempt++
+		logger.Debug("connect attempt", "component", "diagnostics", "attempt", attempt, "online", d.client.OnlineStatus())
+
+		if !d.client.OnlineStatus() && attempt < d.options.Retry.MaxAttempts {
+			delay := d.options.Retry.nextDelay(attempt)
+			logger.Debug("retrying connect", "component", "diagnostics", "attempt", attempt, "delay_ms", delay.Milliseconds())
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				logger.Error("check transmission canceled", "component", "diagnostics", "attempt", attempt, "err", ctx.Err())
+				return ctx.Err()
+			}
+		}
 	}
 
 	if !d.client.OnlineStatus() {
-		return errors.New("unable to connect")
+		logger.Error("check transmission failed", "component", "diagnostics", "attempts", attempt, "err", ErrUnableToConnect)
+		return ErrUnableToConnect
+	}
+
+	if err := d.client.Send(ctx, DiagnosticMessage); err != nil {
+		logger.Error("check transmission failed", "component", "diagnostics", "err", err)
+		return err
 	}
 
-	d.client.Send(DiagnosticMessage)
-	d.DiagnosticInfo = d.client.Receive()
+	info, err := d.client.Receive(ctx)
+	if err != nil {
+		logger.Error("check transmission failed", "component", "diagnostics", "err", err)
+		return err
+	}
+
+	formatted, err := formatDiagnosticInfo(info, d.options.Format)
+	if err != nil {
+		err = fmt.Errorf("telemetry: formatting diagnostic info: %w", err)
+		logger.Error("check transmission failed", "component", "diagnostics", "err", err)
+		return err
+	}
+	d.DiagnosticInfo = formatted
+	logger.Info("check transmission succeeded", "component", "diagnostics", "attempts", attempt, "elapsed_ms", time.Since(start).Milliseconds())
 	return nil
 }
@@ -0,0 +1,144 @@
+package textconverter
+
+import (
+	"html"
+	"strings"
+)
+
+// Escaper controls how individual lines are escaped before being embedded in
+// HTML output. Different runtimes disagree on the details (Go's
+// html.EscapeString does not match Python's html.escape, for example), so
+// callers porting a system from another language can select the profile that
+// matches the original output instead of silently drifting.
+type Escaper interface {
+	Escape(s string) string
+}
+
+// GoStdEscaper escapes using the standard library's html.EscapeString: it
+// escapes & < > " ' as &amp; &lt; &gt; &#34; &#39;.
+type GoStdEscaper struct{}
+
+func (GoStdEscaper) Escape(s string) string { return html.EscapeString(s) }
+
+// PythonCompatEscaper matches Python's html.escape(s, quote=True): it escapes
+// & < > " ' as &amp; &lt; &gt; &quot; &#x27;.
+type PythonCompatEscaper struct{}
+
+func (PythonCompatEscaper) Escape(s string) string {
+	r := strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&quot;",
+		"'", "&#x27;",
+	)
+	return r.Replace(s)
+}
+
+// XMLStrictEscaper escapes the five characters XML requires to be escaped in
+// text content: & < > " ' as &amp; &lt; &gt; &quot; &apos;.
+type XMLStrictEscaper struct{}
+
+func (XMLStrictEscaper) Escape(s string) string {
+	r := strings.NewReplacer(
+		"&", "&amp;",
+		"<", "&lt;",
+		">", "&gt;",
+		`"`, "&quot;",
+		"'", "&apos;",
+	)
+	return r.Replace(s)
+}
+
+// LineBreak selects how line breaks are rendered between escaped lines.
+type LineBreak int
+
+const (
+	// LineBreakXHTML renders "<br />" (the historical default).
+	LineBreakXHTML LineBreak = iota
+	// LineBreakHTML renders the unclosed "<br>" tag.
+	LineBreakHTML
+	// LineBreakNewline renders a plain "\n" with no HTML tag at all.
+	LineBreakNewline
+)
+
+func (lb LineBreak) marker() string {
+	switch lb {
+	case LineBreakHTML:
+		return "<br>"
+	case LineBreakNewline:
+		return "\n"
+	default:
+		return "<br />"
+	}
+}
+
+// Options configures UnicodeFileToHTMLWithOptions and HTMLPagesConverter's
+// escaping and line-break behavior.
+type Options struct {
+	// Escaper defaults to GoStdEscaper when nil.
+	Escaper Escaper
+	// LineBreak defaults to LineBreakXHTML (the zero value).
+	LineBreak LineBreak
+	// WrapParagraphs groups consecutive non-empty lines into "<p>...</p>"
+	// blocks, joining the lines inside a paragraph with LineBreak, instead of
+	// emitting a flat LineBreak-joined stream.
+	WrapParagraphs bool
+}
+
+func (o Options) escaper() Escaper {
+	if o.Escaper == nil {
+		return GoStdEscaper{}
+	}
+	return o.Escaper
+}
+
+// renderLines escapes and joins lines according to opts.
+func renderLines(lines []string, opts Options) string {
+	if opts.WrapParagraphs {
+		return renderParagraphs(lines, opts)
+	}
+
+	escaper := opts.escaper()
+	marker := opts.LineBreak.marker()
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(escaper.Escape(line))
+		b.WriteString(marker)
+	}
+	return b.String()
+}
+
+func renderParagraphs(lines []string, opts Options) string {
+	escaper := opts.escaper()
+	marker := opts.LineBreak.marker()
+
+	var b strings.Builder
+	var para []string
+	flush := func() {
+		if len(para) == 0 {
+			return
+		}
+		b.WriteString("<p>")
+		for i, line := range para {
+			if i > 0 {
+				b.WriteString(marker)
+			}
+			b.WriteString(escaper.Escape(line))
+		}
+		b.WriteString("</p>")
+		para = para[:0]
+	}
+
+	for _, line := range lines {
+		if line == "" {
+			flush()
+			continue
+		}
+		para = append(para, line)
+	}
+	flush()
+
+	return b.String()
+}
@@ -0,0 +1,97 @@
+package textconverter
+
+import "testing"
+
+func TestEscapers(t *testing.T) {
+	const input = `<'straight' & "double">`
+
+	testCases := []struct {
+		name     string
+		escaper  Escaper
+		expected string
+	}{
+		{
+			name:     "GoStdEscaper",
+			escaper:  GoStdEscaper{},
+			expected: `&lt;&#39;straight&#39; &amp; &#34;double&#34;&gt;`,
+		},
+		{
+			name:     "PythonCompatEscaper",
+			escaper:  PythonCompatEscaper{},
+			expected: `&lt;&#x27;straight&#x27; &amp; &quot;double&quot;&gt;`,
+		},
+		{
+			name:     "XMLStrictEscaper",
+			escaper:  XMLStrictEscaper{},
+			expected: `&lt;&apos;straight&apos; &amp; &quot;double&quot;&gt;`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.escaper.Escape(input)
+			if actual != tc.expected {
+				t.Errorf("Escape mismatch.\nExpected: %q\nActual:   %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestUnicodeFileToHTMLWithOptions(t *testing.T) {
+	t.Run("PythonCompatEscaper_matches_python_html_escape", func(t *testing.T) {
+		filename := createTestFile(t, `<'straight' & "double">`)
+
+		actual, err := UnicodeFileToHTMLWithOptions(filename, Options{Escaper: PythonCompatEscaper{}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := `&lt;&#x27;straight&#x27; &amp; &quot;double&quot;&gt;<br />`
+		if actual != expected {
+			t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, actual)
+		}
+	})
+
+	t.Run("LineBreakNewline", func(t *testing.T) {
+		filename := createTestFile(t, "line 1\nline 2")
+
+		actual, err := UnicodeFileToHTMLWithOptions(filename, Options{LineBreak: LineBreakNewline})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := "line 1\nline 2\n"
+		if actual != expected {
+			t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, actual)
+		}
+	})
+
+	t.Run("WrapParagraphs_groups_nonEmpty_runs", func(t *testing.T) {
+		filename := createTestFile(t, "p1 line1\np1 line2\n\np2 line1")
+
+		actual, err := UnicodeFileToHTMLWithOptions(filename, Options{WrapParagraphs: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := "<p>p1 line1<br />p1 line2</p><p>p2 line1</p>"
+		if actual != expected {
+			t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, actual)
+		}
+	})
+}
+
+func TestUnicodeFileToHTMLWithOptionsFS(t *testing.T) {
+	fsys := NewMemFs()
+	fsys.AddFile("doc.txt", []byte(`<'straight' & "double">`))
+
+	actual, err := UnicodeFileToHTMLWithOptionsFS(fsys, "doc.txt", Options{Escaper: PythonCompatEscaper{}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `&lt;&#x27;straight&#x27; &amp; &quot;double&quot;&gt;<br />`
+	if actual != expected {
+		t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, actual)
+	}
+}
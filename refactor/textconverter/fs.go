@@ -0,0 +1,142 @@
+package textconverter
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// ReadSeekCloser is the minimal handle an Fs needs to hand back for a file:
+// positioned random access (so concurrent page reads don't race on a shared
+// offset) plus the ability to release it.
+type ReadSeekCloser interface {
+	io.ReaderAt
+	io.ReadSeeker
+	io.Closer
+}
+
+// Fs abstracts the filesystem operations textconverter needs, mirroring the
+// afero pattern so callers can swap real disk access for embedded assets,
+// archives, or in-memory buffers in tests.
+type Fs interface {
+	Open(name string) (ReadSeekCloser, error)
+	Stat(name string) (fs.FileInfo, error)
+}
+
+// OsFs is the default Fs, backed directly by the OS filesystem.
+type OsFs struct{}
+
+// NewOsFs creates an OsFs.
+func NewOsFs() OsFs { return OsFs{} }
+
+func (OsFs) Open(name string) (ReadSeekCloser, error) { return os.Open(name) }
+func (OsFs) Stat(name string) (fs.FileInfo, error)    { return os.Stat(name) }
+
+// memFile is an in-memory ReadSeekCloser backed by a byte slice.
+type memFile struct {
+	*strings.Reader
+}
+
+func (memFile) Close() error { return nil }
+
+type memFileInfo struct {
+	name string
+	size int64
+}
+
+func (i memFileInfo) Name() string       { return i.name }
+func (i memFileInfo) Size() int64        { return i.size }
+func (i memFileInfo) Mode() fs.FileMode  { return 0 }
+func (i memFileInfo) ModTime() time.Time { return time.Time{} }
+func (i memFileInfo) IsDir() bool        { return false }
+func (i memFileInfo) Sys() interface{}   { return nil }
+
+// MemFs is an in-memory Fs, primarily useful for tests and for sources that
+// have already been loaded into memory (e.g. a tarball entry).
+type MemFs struct {
+	files map[string][]byte
+}
+
+// NewMemFs creates an empty MemFs.
+func NewMemFs() *MemFs {
+	return &MemFs{files: make(map[string][]byte)}
+}
+
+// AddFile registers content under name so it can later be Open'd.
+func (m *MemFs) AddFile(name string, content []byte) {
+	m.files[name] = content
+}
+
+func (m *MemFs) Open(name string) (ReadSeekCloser, error) {
+	content, ok := m.files[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return memFile{strings.NewReader(string(content))}, nil
+}
+
+func (m *MemFs) Stat(name string) (fs.FileInfo, error) {
+	content, ok := m.files[name]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return memFileInfo{name: name, size: int64(len(content))}, nil
+}
+
+// ReadOnlyFs wraps an Fs and rejects anything that looks like a mutation,
+// which in practice means there is nothing to reject today: the Fs interface
+// is already read-only. It exists so callers can signal intent and so future
+// write methods added to Fs fail closed by default instead of silently
+// becoming writable through this wrapper.
+type ReadOnlyFs struct {
+	Source Fs
+}
+
+// NewReadOnlyFs wraps fs so it can be passed where read-only access is required.
+func NewReadOnlyFs(source Fs) ReadOnlyFs {
+	return ReadOnlyFs{Source: source}
+}
+
+func (r ReadOnlyFs) Open(name string) (ReadSeekCloser, error) { return r.Source.Open(name) }
+func (r ReadOnlyFs) Stat(name string) (fs.FileInfo, error)    { return r.Source.Stat(name) }
+
+// BasePathFs sandboxes another Fs to a base directory, rejecting any name
+// that would escape it (e.g. via "..") once cleaned and joined.
+type BasePathFs struct {
+	Source Fs
+	Base   string
+}
+
+// NewBasePathFs returns a Fs that resolves all names relative to base,
+// refusing to serve paths that escape it.
+func NewBasePathFs(source Fs, base string) BasePathFs {
+	return BasePathFs{Source: source, Base: base}
+}
+
+func (b BasePathFs) resolve(name string) (string, error) {
+	full := filepath.Join(b.Base, name)
+	rel, err := filepath.Rel(b.Base, full)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", os.ErrPermission
+	}
+	return full, nil
+}
+
+func (b BasePathFs) Open(name string) (ReadSeekCloser, error) {
+	full, err := b.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	return b.Source.Open(full)
+}
+
+func (b BasePathFs) Stat(name string) (fs.FileInfo, error) {
+	full, err := b.resolve(name)
+	if err != nil {
+		return nil, err
+	}
+	return b.Source.Stat(full)
+}
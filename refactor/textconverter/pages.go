@@ -0,0 +1,352 @@
+package textconverter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pageBreakMarker is the sentinel line that separates pages in the source
+// file; it is stripped from the rendered output.
+const pageBreakMarker = "PAGE_BREAK"
+
+// scanChunkSize is the buffer size used by buildBreakIndex when scanning a
+// file for page breaks, keeping memory use constant regardless of file size.
+const scanChunkSize = 64 * 1024
+
+// HTMLPagesConverter converts a file with page breaks into separate HTML
+// pages. It keeps the source file open for its lifetime and serves pages via
+// positioned reads, so GetHTMLPage/WriteHTMLPage do not re-open or re-scan
+// the file on every call.
+type HTMLPagesConverter struct {
+	Filename string
+	// Options configures escaping, line-break style, and paragraph wrapping
+	// for every page rendered by this converter; the zero value matches the
+	// historical GoStdEscaper/"<br />" behavior.
+	Options Options
+
+	fs   Fs
+	file ReadSeekCloser
+
+	mu     sync.RWMutex
+	breaks []int64
+	closed bool
+}
+
+// NewHTMLPagesConverter creates a new HTMLPagesConverter backed by the OS
+// filesystem.
+func NewHTMLPagesConverter(filename string) (*HTMLPagesConverter, error) {
+	return NewHTMLPagesConverterFS(OsFs{}, filename)
+}
+
+// NewHTMLPagesConverterFS creates a new HTMLPagesConverter that reads name
+// through fsys, allowing callers to sandbox conversion to a working
+// directory or serve it from an in-memory source. The returned converter
+// keeps a handle on the file open until Close is called. When fsys is an
+// OsFs, a sidecar "<name>.idx" file is consulted (and (re)written) to skip
+// the O(N) break scan on subsequent opens of an unchanged file.
+func NewHTMLPagesConverterFS(fsys Fs, name string) (*HTMLPagesConverter, error) {
+	info, err := fsys.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	breaks, err := loadOrBuildBreaks(fsys, name, info, file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &HTMLPagesConverter{
+		Filename: name,
+		fs:       fsys,
+		file:     file,
+		breaks:   breaks,
+	}, nil
+}
+
+// loadOrBuildBreaks returns the page-break offset table for name, preferring
+// a fresh sidecar index file when one is available and falling back to a
+// full scan of file otherwise.
+func loadOrBuildBreaks(fsys Fs, name string, info fs.FileInfo, file io.Reader) ([]int64, error) {
+	if _, ok := fsys.(OsFs); ok {
+		if breaks, err := readIndex(idxPath(name), info.Size(), info.ModTime()); err == nil {
+			return breaks, nil
+		}
+	}
+
+	breaks, err := buildBreakIndex(file)
+	if err != nil {
+		return nil, err
+	}
+	breaks = append(breaks, info.Size())
+
+	if _, ok := fsys.(OsFs); ok {
+		// Writing the sidecar index is an optimization, not a correctness
+		// requirement: ignore failures (e.g. a read-only directory).
+		_ = writeIndex(idxPath(name), info.Size(), info.ModTime(), breaks)
+	}
+
+	return breaks, nil
+}
+
+// buildBreakIndex scans r for pageBreakMarker lines in fixed-size chunks via
+// bytes.IndexByte, returning the byte offset immediately after each marker
+// line. It never holds more than scanChunkSize bytes (plus one partial line)
+// in memory regardless of the input size.
+func buildBreakIndex(r io.Reader) ([]int64, error) {
+	breaks := []int64{0}
+	var totalBytes int64
+	var carry []byte
+	buf := make([]byte, scanChunkSize)
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			data := buf[:n]
+			if len(carry) > 0 {
+				data = append(carry, data...)
+				carry = nil
+			}
+
+			start := 0
+			for {
+				idx := bytes.IndexByte(data[start:], '\n')
+				if idx < 0 {
+					break
+				}
+				lineEnd := start + idx + 1
+				totalBytes += int64(lineEnd - start)
+				if bytes.Contains(data[start:lineEnd], []byte(pageBreakMarker)) {
+					breaks = append(breaks, totalBytes)
+				}
+				start = lineEnd
+			}
+			if start < len(data) {
+				carry = append([]byte(nil), data[start:]...)
+			}
+		}
+
+		if err == io.EOF {
+			if len(carry) > 0 {
+				totalBytes += int64(len(carry))
+				if bytes.Contains(carry, []byte(pageBreakMarker)) {
+					breaks = append(breaks, totalBytes)
+				}
+			}
+			return breaks, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// PageCount returns the number of pages the converter has indexed.
+func (c *HTMLPagesConverter) PageCount() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.breaks) - 1
+}
+
+// WriteHTMLPage streams the escaped HTML for page directly to w without
+// buffering the whole page in memory, and returns the number of bytes
+// written. Concurrent calls are safe: pages are read via positioned reads
+// against the shared file handle rather than a seek-then-read sequence.
+func (c *HTMLPagesConverter) WriteHTMLPage(page int, w io.Writer) (int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.closed {
+		return 0, os.ErrClosed
+	}
+	if page < 0 || page+1 >= len(c.breaks) {
+		return 0, os.ErrNotExist
+	}
+
+	pageStart := c.breaks[page]
+	pageEnd := c.breaks[page+1]
+
+	section := io.NewSectionReader(c.file, pageStart, pageEnd-pageStart)
+	reader := bufio.NewReader(section)
+	bw := bufio.NewWriter(w)
+
+	var written int64
+	writeString := func(s string) error {
+		n, err := bw.WriteString(s)
+		written += int64(n)
+		return err
+	}
+
+	escaper := c.Options.escaper()
+	marker := c.Options.LineBreak.marker()
+
+	var para []string
+	flushParagraph := func() error {
+		if len(para) == 0 {
+			return nil
+		}
+		if err := writeString("<p>"); err != nil {
+			return err
+		}
+		for i, line := range para {
+			if i > 0 {
+				if err := writeString(marker); err != nil {
+					return err
+				}
+			}
+			if err := writeString(escaper.Escape(line)); err != nil {
+				return err
+			}
+		}
+		para = para[:0]
+		return writeString("</p>")
+	}
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return written, err
+		}
+		if err == io.EOF && line == "" {
+			break
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if !strings.Contains(line, pageBreakMarker) {
+			if c.Options.WrapParagraphs {
+				if line == "" {
+					if werr := flushParagraph(); werr != nil {
+						return written, werr
+					}
+				} else {
+					para = append(para, line)
+				}
+			} else {
+				if werr := writeString(escaper.Escape(line)); werr != nil {
+					return written, werr
+				}
+				if werr := writeString(marker); werr != nil {
+					return written, werr
+				}
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if c.Options.WrapParagraphs {
+		if werr := flushParagraph(); werr != nil {
+			return written, werr
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return written, err
+	}
+	return written, nil
+}
+
+// GetHTMLPage returns the HTML for a specific page.
+func (c *HTMLPagesConverter) GetHTMLPage(page int) (string, error) {
+	var buf bytes.Buffer
+	if _, err := c.WriteHTMLPage(page, &buf); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// Close releases the converter's underlying file handle. It is safe to call
+// more than once.
+func (c *HTMLPagesConverter) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.file.Close()
+}
+
+// --- sidecar break-index file ---
+
+const idxMagic = "TCI1"
+
+var errStaleIndex = errors.New("textconverter: stale index")
+
+func idxPath(name string) string {
+	return name + ".idx"
+}
+
+// writeIndex persists breaks (as a magic header, the source file's size and
+// mtime, and varint-encoded deltas) so a later call with an unchanged file
+// can skip the O(N) rescan via readIndex.
+func writeIndex(path string, size int64, modTime time.Time, breaks []int64) error {
+	var buf bytes.Buffer
+	buf.WriteString(idxMagic)
+
+	var header [16]byte
+	binary.LittleEndian.PutUint64(header[0:8], uint64(size))
+	binary.LittleEndian.PutUint64(header[8:16], uint64(modTime.UnixNano()))
+	buf.Write(header[:])
+
+	varintBuf := make([]byte, binary.MaxVarintLen64)
+	var prev int64
+	for _, b := range breaks {
+		n := binary.PutUvarint(varintBuf, uint64(b-prev))
+		buf.Write(varintBuf[:n])
+		prev = b
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o644)
+}
+
+// readIndex loads a sidecar index written by writeIndex, returning
+// errStaleIndex if it does not match the current file size/mtime so the
+// caller falls back to rebuilding it.
+func readIndex(path string, size int64, modTime time.Time) ([]int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < len(idxMagic)+16 || string(data[:len(idxMagic)]) != idxMagic {
+		return nil, errors.New("textconverter: corrupt index")
+	}
+	pos := len(idxMagic)
+
+	wantSize := int64(binary.LittleEndian.Uint64(data[pos : pos+8]))
+	pos += 8
+	wantModTime := int64(binary.LittleEndian.Uint64(data[pos : pos+8]))
+	pos += 8
+
+	if wantSize != size || wantModTime != modTime.UnixNano() {
+		return nil, errStaleIndex
+	}
+
+	var breaks []int64
+	var prev int64
+	for pos < len(data) {
+		delta, n := binary.Uvarint(data[pos:])
+		if n <= 0 {
+			return nil, errors.New("textconverter: corrupt index")
+		}
+		pos += n
+		prev += int64(delta)
+		breaks = append(breaks, prev)
+	}
+	return breaks, nil
+}
@@ -0,0 +1,204 @@
+package textconverter
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// TestSidecarIndexReuse verifies that a second open of the same unchanged
+// file reuses the sidecar ".idx" written by the first open instead of
+// rescanning, while still producing the same break table.
+func TestSidecarIndexReuse(t *testing.T) {
+	filename := createTestFile(t, "Page 0\nPAGE_BREAK\nPage 1 with < & >\nPAGE_BREAK\n\nPAGE_BREAK\nLast Page")
+	t.Cleanup(func() { os.Remove(idxPath(filename)) })
+
+	first, err := NewHTMLPagesConverter(filename)
+	if err != nil {
+		t.Fatalf("first open failed: %v", err)
+	}
+	wantBreaks := append([]int64(nil), first.breaks...)
+	first.Close()
+
+	if _, err := os.Stat(idxPath(filename)); err != nil {
+		t.Fatalf("expected sidecar index to be written: %v", err)
+	}
+
+	second, err := NewHTMLPagesConverter(filename)
+	if err != nil {
+		t.Fatalf("second open failed: %v", err)
+	}
+	defer second.Close()
+
+	if !reflect.DeepEqual(second.breaks, wantBreaks) {
+		t.Errorf("breaks mismatch after index reuse.\nExpected: %v\nActual:   %v", wantBreaks, second.breaks)
+	}
+}
+
+// TestSidecarIndexStaleOnChange verifies that a stale sidecar (file size
+// changed) is ignored rather than producing an incorrect break table.
+func TestSidecarIndexStaleOnChange(t *testing.T) {
+	filename := createTestFile(t, "Page 0\nPAGE_BREAK\nPage 1")
+	t.Cleanup(func() { os.Remove(idxPath(filename)) })
+
+	first, err := NewHTMLPagesConverter(filename)
+	if err != nil {
+		t.Fatalf("first open failed: %v", err)
+	}
+	first.Close()
+
+	if err := os.WriteFile(filename, []byte("Page 0\nPAGE_BREAK\nPage 1, now longer"), 0o644); err != nil {
+		t.Fatalf("failed to rewrite file: %v", err)
+	}
+
+	second, err := NewHTMLPagesConverter(filename)
+	if err != nil {
+		t.Fatalf("second open failed: %v", err)
+	}
+	defer second.Close()
+
+	html, err := second.GetHTMLPage(1)
+	if err != nil {
+		t.Fatalf("unexpected error reading page 1: %v", err)
+	}
+	if html != "Page 1, now longer<br />" {
+		t.Errorf("stale index produced wrong page content: %q", html)
+	}
+}
+
+// TestWriteHTMLPageStreamsDirectly verifies WriteHTMLPage writes the same
+// content as GetHTMLPage without buffering it in a string first.
+func TestWriteHTMLPageStreamsDirectly(t *testing.T) {
+	filename := createTestFile(t, "Page 0\nPAGE_BREAK\nPage 1 with < & >")
+	converter, err := NewHTMLPagesConverter(filename)
+	if err != nil {
+		t.Fatalf("setup failed: %v", err)
+	}
+	defer converter.Close()
+
+	var buf bytes.Buffer
+	n, err := converter.WriteHTMLPage(1, &buf)
+	if err != nil {
+		t.Fatalf("WriteHTMLPage failed: %v", err)
+	}
+
+	expected := "Page 1 with &lt; &amp; &gt;<br />"
+	if int(n) != len(expected) {
+		t.Errorf("byte count mismatch: got %d, want %d", n, len(expected))
+	}
+	if buf.String() != expected {
+		t.Errorf("content mismatch.\nExpected: %q\nActual:   %q", expected, buf.String())
+	}
+}
+
+// TestWriteHTMLPageHonorsOptions verifies that HTMLPagesConverter.Options'
+// LineBreak and WrapParagraphs settings apply to streamed pages, not just
+// the non-paged UnicodeFileToHTMLWithOptions path.
+func TestWriteHTMLPageHonorsOptions(t *testing.T) {
+	t.Run("LineBreakNewline", func(t *testing.T) {
+		filename := createTestFile(t, "line 1\nline 2")
+		converter, err := NewHTMLPagesConverter(filename)
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		defer converter.Close()
+		converter.Options = Options{LineBreak: LineBreakNewline}
+
+		html, err := converter.GetHTMLPage(0)
+		if err != nil {
+			t.Fatalf("GetHTMLPage failed: %v", err)
+		}
+		if expected := "line 1\nline 2\n"; html != expected {
+			t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, html)
+		}
+	})
+
+	t.Run("WrapParagraphs_groups_nonEmpty_runs", func(t *testing.T) {
+		filename := createTestFile(t, "p1 line1\np1 line2\n\np2 line1")
+		converter, err := NewHTMLPagesConverter(filename)
+		if err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+		defer converter.Close()
+		converter.Options = Options{WrapParagraphs: true}
+
+		html, err := converter.GetHTMLPage(0)
+		if err != nil {
+			t.Fatalf("GetHTMLPage failed: %v", err)
+		}
+		if expected := "<p>p1 line1<br />p1 line2</p><p>p2 line1</p>"; html != expected {
+			t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", expected, html)
+		}
+	})
+}
+
+func benchmarkContent(pages, linesPerPage int) string {
+	var b bytes.Buffer
+	for p := 0; p < pages; p++ {
+		for l := 0; l < linesPerPage; l++ {
+			b.WriteString("the quick brown fox jumps over the lazy dog\n")
+		}
+		if p < pages-1 {
+			b.WriteString("PAGE_BREAK\n")
+		}
+	}
+	return b.String()
+}
+
+// BenchmarkNewHTMLPagesConverter_LargeFile demonstrates that indexing scales
+// with a fixed-size scan buffer rather than loading the whole file into
+// memory at once.
+func BenchmarkNewHTMLPagesConverter_LargeFile(b *testing.B) {
+	f, err := os.CreateTemp("", "bench_pages_*.txt")
+	if err != nil {
+		b.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer os.Remove(idxPath(f.Name()))
+
+	if _, err := f.WriteString(benchmarkContent(200, 5000)); err != nil {
+		b.Fatalf("failed to write temp file: %v", err)
+	}
+	f.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		os.Remove(idxPath(f.Name())) // force a full rescan each iteration
+		converter, err := NewHTMLPagesConverter(f.Name())
+		if err != nil {
+			b.Fatalf("NewHTMLPagesConverter failed: %v", err)
+		}
+		converter.Close()
+	}
+}
+
+// BenchmarkWriteHTMLPage demonstrates constant-memory page rendering: a
+// single page is written to io.Discard regardless of overall file size.
+func BenchmarkWriteHTMLPage(b *testing.B) {
+	f, err := os.CreateTemp("", "bench_page_*.txt")
+	if err != nil {
+		b.Fatalf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(f.Name())
+	defer os.Remove(idxPath(f.Name()))
+
+	if _, err := f.WriteString(benchmarkContent(200, 5000)); err != nil {
+		b.Fatalf("failed to write temp file: %v", err)
+	}
+	f.Close()
+
+	converter, err := NewHTMLPagesConverter(f.Name())
+	if err != nil {
+		b.Fatalf("NewHTMLPagesConverter failed: %v", err)
+	}
+	defer converter.Close()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := converter.WriteHTMLPage(100, io.Discard); err != nil {
+			b.Fatalf("WriteHTMLPage failed: %v", err)
+		}
+	}
+}
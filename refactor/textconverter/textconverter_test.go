@@ -2,6 +2,7 @@ package textconverter
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"reflect"
 	"strings"
@@ -66,7 +67,7 @@ func TestUnicodeFileToHTML(t *testing.T) {
 			name:        "Golden_convert_to_html_specialChars",
 			fileContent: `<'straight' & "double">`,
 			// The refactored Go `html.EscapeString` differs from Python's `html.escape`.
-			// Go does not escape ' and uses &#34; for ".
+			// Go uses &#34; for " and &#39; for ', while Python uses &quot; and &#x27;.
 			// This test asserts against the original Python behavior (the golden output)
 			// to prove the refactoring is not behaviorally equivalent in this case.
 			expectedHTML: `&lt;&#x27;straight&#x27; &amp; &quot;double&quot;&gt;<br />`,
@@ -118,13 +119,11 @@ func TestUnicodeFileToHTML(t *testing.T) {
 			// from the original Python implementation for single quotes and double quotes.
 			// This highlights the behavioral discrepancy found during validation.
 			// The original python output is &quot; and &#x27;
-			// The refactored Go output is &#34; and ' (no escape)
+			// The refactored Go output is &#34; and &#39;
 			// We adjust the actual result to compare against the golden standard.
 			// In a real-world scenario, this test failure would trigger a bug report against the refactoring.
 			adjustedActual := strings.ReplaceAll(actualHTML, "&#34;", "&quot;")
-			if !strings.Contains(adjustedActual, "&#x27;") {
-				adjustedActual = strings.ReplaceAll(adjustedActual, "'", "&#x27;")
-			}
+			adjustedActual = strings.ReplaceAll(adjustedActual, "&#39;", "&#x27;")
 
 			if adjustedActual != tc.expectedHTML {
 				t.Errorf("HTML mismatch.\nExpected: %q\nActual:   %q", tc.expectedHTML, adjustedActual)
@@ -249,7 +248,7 @@ func TestHTMLPagesConverter(t *testing.T) {
 				pt := pageTest
 				testName := pt.goldenKey
 				if testName == "" {
-					testName = "get_page_" + string(pt.pageIndex)
+					testName = "get_page_" + fmt.Sprint(pt.pageIndex)
 				}
 				t.Run(testName, func(t *testing.T) {
 					html, err := converter.GetHTMLPage(pt.pageIndex)
@@ -266,7 +265,7 @@ func TestHTMLPagesConverter(t *testing.T) {
 				et := errorTest
 				testName := et.goldenKey
 				if testName == "" {
-					testName = "get_page_error_" + string(et.pageIndex)
+					testName = "get_page_error_" + fmt.Sprint(et.pageIndex)
 				}
 				t.Run(testName, func(t *testing.T) {
 					_, err := converter.GetHTMLPage(et.pageIndex)
@@ -279,18 +278,59 @@ func TestHTMLPagesConverter(t *testing.T) {
 	}
 
 	t.Run("Robustness_file_deleted_after_init", func(t *testing.T) {
+		// HTMLPagesConverter now keeps a single file handle open for its
+		// lifetime instead of reopening the path on every GetHTMLPage call,
+		// so deleting the path no longer invalidates pages already indexed:
+		// on POSIX, an open file descriptor keeps the underlying data
+		// readable until it is closed.
 		filename := createTestFile(t, "some content\nPAGE_BREAK\nmore")
 		converter, err := NewHTMLPagesConverter(filename)
 		if err != nil {
 			t.Fatalf("Setup failed: NewHTMLPagesConverter returned an error: %v", err)
 		}
+		defer converter.Close()
 
-		// Delete the file before calling GetHTMLPage
 		os.Remove(filename)
 
-		_, err = converter.GetHTMLPage(0)
-		if !errors.Is(err, os.ErrNotExist) {
-			t.Fatalf("Expected os.ErrNotExist when file is deleted before GetHTMLPage, but got: %v", err)
+		html, err := converter.GetHTMLPage(0)
+		if err != nil {
+			t.Fatalf("Expected page read to succeed via the already-open handle, but got: %v", err)
+		}
+		if html != "some content<br />" {
+			t.Errorf("HTML mismatch after deletion.\nExpected: %q\nActual:   %q", "some content<br />", html)
+		}
+	})
+
+	t.Run("Close_ReturnsErrClosed_OnSubsequentWrite", func(t *testing.T) {
+		filename := createTestFile(t, "some content\nPAGE_BREAK\nmore")
+		converter, err := NewHTMLPagesConverter(filename)
+		if err != nil {
+			t.Fatalf("Setup failed: NewHTMLPagesConverter returned an error: %v", err)
+		}
+
+		if err := converter.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+		// Closing twice must be safe.
+		if err := converter.Close(); err != nil {
+			t.Fatalf("second Close failed: %v", err)
+		}
+
+		if _, err := converter.GetHTMLPage(0); !errors.Is(err, os.ErrClosed) {
+			t.Fatalf("Expected os.ErrClosed after Close, but got: %v", err)
+		}
+	})
+
+	t.Run("PageCount_matchesNumberOfPages", func(t *testing.T) {
+		filename := createTestFile(t, "Page 0\nPAGE_BREAK\nPage 1")
+		converter, err := NewHTMLPagesConverter(filename)
+		if err != nil {
+			t.Fatalf("Setup failed: NewHTMLPagesConverter returned an error: %v", err)
+		}
+		defer converter.Close()
+
+		if got := converter.PageCount(); got != 2 {
+			t.Errorf("PageCount: got %d, want %d", got, 2)
 		}
 	})
 }